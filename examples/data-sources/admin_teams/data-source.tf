@@ -0,0 +1,8 @@
+data "slack_admin_teams" "all" {}
+
+resource "slack_admin_conversation_teams" "announcements" {
+  channel_id = "C0123456789"
+  target_team_ids = [
+    for t in data.slack_admin_teams.all.teams : t.id if t.discoverability != "unlisted"
+  ]
+}
@@ -1,2 +1,11 @@
 data "slack_all_usergroups" "example" {
 }
+
+data "slack_all_usergroups" "teams_of_john" {
+  handle_regex = "^team-"
+  has_user     = "U0123456789"
+}
+
+output "team_handles_of_john" {
+  value = keys(data.slack_all_usergroups.teams_of_john.usergroups_by_handle)
+}
@@ -1,2 +1,11 @@
 data "slack_all_users" "example" {
 }
+
+data "slack_all_users" "employees" {
+  include_restricted = false
+  email_domain       = "example.com"
+}
+
+output "employee_ids" {
+  value = keys(data.slack_all_users.employees.users_by_id)
+}
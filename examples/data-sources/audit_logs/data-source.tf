@@ -0,0 +1,17 @@
+data "slack_auth_identity" "terraform" {}
+
+data "slack_audit_logs" "oncall_changes" {
+  action   = "subteam_updated,subteam_members_updated"
+  entity   = "S0123456789"
+  lookback = "24h"
+}
+
+check "oncall_managed_by_terraform" {
+  assert {
+    condition = length([
+      for e in data.slack_audit_logs.oncall_changes.entries : e
+      if e.actor_id != data.slack_auth_identity.terraform.user_id
+    ]) == 0
+    error_message = "The on-call user group was edited outside of Terraform in the last day."
+  }
+}
@@ -0,0 +1,5 @@
+data "slack_auth_identity" "me" {}
+
+output "can_manage_usergroups" {
+  value = contains(data.slack_auth_identity.me.scopes, "usergroups:write")
+}
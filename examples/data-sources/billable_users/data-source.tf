@@ -0,0 +1,13 @@
+data "slack_billable_users" "billing" {}
+
+data "slack_billable_users" "deactivated_but_billed" {
+  billing_active = true
+}
+
+output "billable_seats" {
+  value = data.slack_billable_users.billing.total_billable
+}
+
+output "reclaimable_licenses" {
+  value = [for u in data.slack_billable_users.deactivated_but_billed.users : u.email if u.deleted]
+}
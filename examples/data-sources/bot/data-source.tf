@@ -0,0 +1,11 @@
+data "slack_bot" "deploy_bot" {
+  id = "B0123456789"
+}
+
+data "slack_bot" "by_user" {
+  user_id = "U0123456789"
+}
+
+output "deploy_bot_user_id" {
+  value = data.slack_bot.deploy_bot.user_id
+}
@@ -1,3 +1,8 @@
 data "slack_conversation" "example" {
   channel_id = "C1234567890"
 }
+
+data "slack_conversation" "by_name" {
+  name                = "general"
+  include_num_members = true
+}
@@ -0,0 +1,9 @@
+data "slack_conversations" "teams" {
+  types            = ["public_channel", "private_channel"]
+  exclude_archived = true
+  name_prefix      = "team-"
+}
+
+output "team_channel_ids" {
+  value = [for c in data.slack_conversations.teams.conversations : c.id]
+}
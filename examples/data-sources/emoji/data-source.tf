@@ -0,0 +1,16 @@
+data "slack_emoji" "all" {
+  include_standard = true
+}
+
+resource "slack_user_status" "vacation" {
+  user_id      = "U1234567890"
+  status_text  = "On vacation"
+  status_emoji = ":palm_tree:"
+
+  lifecycle {
+    precondition {
+      condition     = contains(keys(data.slack_emoji.all.emoji), "palm_tree")
+      error_message = "Emoji :palm_tree: does not exist in this workspace."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "slack_message_permalink" "incident_runbook" {
+  channel_id = "C0123456789"
+  message_ts = "1700000000.123456"
+}
+
+output "incident_runbook_url" {
+  value = data.slack_message_permalink.incident_runbook.permalink
+}
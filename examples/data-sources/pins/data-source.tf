@@ -0,0 +1,7 @@
+data "slack_pins" "announcements" {
+  channel_id = "C0123456789"
+}
+
+output "pinned_message_links" {
+  value = [for p in data.slack_pins.announcements.pins : p.permalink if p.type == "message"]
+}
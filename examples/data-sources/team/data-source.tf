@@ -0,0 +1,5 @@
+data "slack_team" "current" {}
+
+output "workspace_url" {
+  value = "https://${data.slack_team.current.domain}.slack.com"
+}
@@ -0,0 +1,10 @@
+data "slack_team_profile_fields" "fields" {}
+
+data "slack_user" "john" {
+  email                 = "john.doe@example.com"
+  include_custom_fields = true
+}
+
+output "john_department" {
+  value = data.slack_user.john.custom_fields[data.slack_team_profile_fields.fields.field_ids["Department"]]
+}
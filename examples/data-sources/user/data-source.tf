@@ -5,3 +5,8 @@ data "slack_user" "example_1" {
 data "slack_user" "example_2" {
   id = "U1234567890"
 }
+
+data "slack_user" "example_3" {
+  email                 = "user@example.com"
+  include_custom_fields = true
+}
@@ -0,0 +1,13 @@
+data "slack_user" "leaver" {
+  email = "user@example.com"
+}
+
+data "slack_user_conversations" "leaver" {
+  user_id          = data.slack_user.leaver.id
+  types            = ["public_channel", "private_channel"]
+  exclude_archived = true
+}
+
+output "leaver_channels" {
+  value = [for c in data.slack_user_conversations.leaver.conversations : c.name]
+}
@@ -0,0 +1,7 @@
+data "slack_user_usergroups" "mover" {
+  email = "user@example.com"
+}
+
+output "mover_usergroups" {
+  value = [for g in data.slack_user_usergroups.mover.usergroups : g.handle]
+}
@@ -0,0 +1,7 @@
+data "slack_usergroup" "oncall" {
+  handle = "oncall"
+}
+
+output "oncall_users" {
+  value = data.slack_usergroup.oncall.users
+}
@@ -0,0 +1,12 @@
+data "slack_users_by_email" "team" {
+  emails = [
+    "alice@example.com",
+    "bob@example.com",
+  ]
+}
+
+resource "slack_usergroup" "team" {
+  name   = "Team"
+  handle = "team"
+  users  = values(data.slack_users_by_email.team.users)
+}
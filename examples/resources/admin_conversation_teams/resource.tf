@@ -0,0 +1,10 @@
+resource "slack_admin_conversation_teams" "example_1" {
+  channel_id      = "C1234567890"
+  team_id         = "T1234567890"
+  target_team_ids = ["T1234567890", "T0987654321"]
+}
+
+resource "slack_admin_conversation_teams" "example_2" {
+  channel_id  = "C0987654321"
+  org_channel = true
+}
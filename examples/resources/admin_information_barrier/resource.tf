@@ -0,0 +1,14 @@
+resource "slack_usergroup" "trading" {
+  name   = "Trading"
+  handle = "trading"
+}
+
+resource "slack_usergroup" "retail" {
+  name   = "Retail"
+  handle = "retail"
+}
+
+resource "slack_admin_information_barrier" "example" {
+  primary_usergroup_id         = slack_usergroup.trading.id
+  barriered_from_usergroup_ids = [slack_usergroup.retail.id]
+}
@@ -0,0 +1,5 @@
+resource "slack_admin_role_assignment" "example" {
+  role_id   = "Rl0A"
+  entity_id = "C1234567890"
+  user_ids  = ["U1234567890", "U0987654321"]
+}
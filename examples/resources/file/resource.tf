@@ -0,0 +1,5 @@
+resource "slack_file" "example" {
+  source   = "${path.module}/escalation-diagram.png"
+  title    = "Escalation diagram"
+  channels = ["C1234567890"]
+}
@@ -0,0 +1,5 @@
+resource "slack_user_status" "example" {
+  user_id      = "U1234567890"
+  status_text  = "Automated account"
+  status_emoji = ":robot_face:"
+}
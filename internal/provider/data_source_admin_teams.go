@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AdminTeamsDataSource{}
+
+func NewAdminTeamsDataSource() datasource.DataSource {
+	return &AdminTeamsDataSource{}
+}
+
+type AdminTeamsDataSource struct {
+	queries slackExt.Queries
+}
+
+type AdminTeamsDataSourceModel struct {
+	TotalTeams types.Int64                         `tfsdk:"total_teams"`
+	TeamIDs    types.List                          `tfsdk:"team_ids"`
+	Teams      []AdminTeamsDataSourceModelTeamItem `tfsdk:"teams"`
+}
+
+type AdminTeamsDataSourceModelTeamItem struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Domain            types.String `tfsdk:"domain"`
+	TeamURL           types.String `tfsdk:"team_url"`
+	Discoverability   types.String `tfsdk:"discoverability"`
+	PrimaryOwnerID    types.String `tfsdk:"primary_owner_id"`
+	PrimaryOwnerEmail types.String `tfsdk:"primary_owner_email"`
+}
+
+func (d *AdminTeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_teams"
+}
+
+func (d *AdminTeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve all workspaces of an Enterprise Grid organization.
+
+This datasource requires an org-level token with the following scopes:
+
+- admin.teams:read`,
+		Attributes: map[string]schema.Attribute{
+			"total_teams": schema.Int64Attribute{
+				Description: "Number of workspaces returned.",
+				Computed:    true,
+			},
+			"team_ids": schema.ListAttribute{
+				Description: "IDs of all workspaces, in the same order as teams.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"teams": schema.ListNestedAttribute{
+				Description: "List of workspaces in the organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Workspace's Slack ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Workspace's name.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Workspace's domain, i.e. the first label of the team URL's host.",
+							Computed:    true,
+						},
+						"team_url": schema.StringAttribute{
+							Description: "URL of the workspace.",
+							Computed:    true,
+						},
+						"discoverability": schema.StringAttribute{
+							Description: "Who can find and join the workspace: open, invite_only, closed or unlisted.",
+							Computed:    true,
+						},
+						"primary_owner_id": schema.StringAttribute{
+							Description: "User ID of the workspace's primary owner.",
+							Computed:    true,
+						},
+						"primary_owner_email": schema.StringAttribute{
+							Description: "Email address of the workspace's primary owner.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminTeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *AdminTeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AdminTeamsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := d.queries.GetAllAdminTeams(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch workspaces: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack workspaces", map[string]any{"total_teams": len(teams)})
+
+	teamIDs := make([]string, 0, len(teams))
+	resultingList := []AdminTeamsDataSourceModelTeamItem{}
+	for _, team := range teams {
+		teamIDs = append(teamIDs, team.ID)
+		resultingList = append(resultingList, AdminTeamsDataSourceModelTeamItem{
+			ID:                types.StringValue(team.ID),
+			Name:              types.StringValue(team.Name),
+			Domain:            types.StringValue(teamDomain(team.TeamURL)),
+			TeamURL:           types.StringValue(team.TeamURL),
+			Discoverability:   types.StringValue(team.Discoverability),
+			PrimaryOwnerID:    types.StringValue(team.PrimaryOwner.UserID),
+			PrimaryOwnerEmail: types.StringValue(team.PrimaryOwner.Email),
+		})
+	}
+
+	data.Teams = resultingList
+	data.TeamIDs = stringSliceToList(teamIDs)
+	data.TotalTeams = types.Int64Value(int64(len(resultingList)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// teamDomain extracts the workspace domain from a team URL such as https://acme.slack.com/
+// or https://acme.enterprise.slack.com/.
+func teamDomain(teamURL string) string {
+	u, err := url.Parse(teamURL)
+	if err != nil {
+		return ""
+	}
+	domain, _, _ := strings.Cut(u.Hostname(), ".")
+	return domain
+}
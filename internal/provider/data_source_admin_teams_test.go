@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_AdminTeams(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			teams := []slackExt.AdminTeam{
+				{
+					ID:              "<TEAM_A>",
+					Name:            "<NAME_A>",
+					Discoverability: "open",
+					PrimaryOwner:    slackExt.AdminTeamOwner{UserID: "<OWNER_A>", Email: "<OWNER_EMAIL_A>"},
+					TeamURL:         "https://acme-eng.slack.com/",
+				},
+				{ID: "<TEAM_B>", Name: "<NAME_B>", Discoverability: "invite_only", TeamURL: "https://acme-sales.enterprise.slack.com/"},
+			}
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllAdminTeams(gomock.Any()).Return(teams, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_admin_teams" "teams" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "total_teams", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "team_ids.0", tb.ExpectString("<TEAM_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "team_ids.1", tb.ExpectString("<TEAM_B>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.id", tb.ExpectString("<TEAM_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.name", tb.ExpectString("<NAME_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.domain", tb.ExpectString("acme-eng")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.team_url", tb.ExpectString("https://acme-eng.slack.com/")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.discoverability", tb.ExpectString("open")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.primary_owner_id", tb.ExpectString("<OWNER_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.0.primary_owner_email", tb.ExpectString("<OWNER_EMAIL_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.1.domain", tb.ExpectString("acme-sales")),
+			tr.TestCheckResourceAttrWith("data.slack_admin_teams.teams", "teams.1.discoverability", tb.ExpectString("invite_only")),
+		),
+	})
+}
+
+func Test_DataSource_AdminTeams_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllAdminTeams(gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_admin_teams" "teams" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_AdminTeams_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := AdminTeamsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -6,17 +6,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 
 	"github.com/essent/terraform-provider-slack/internal/slackExt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/slack-go/slack"
 )
 
-var _ datasource.DataSource = &AllUserGroupsDataSource{}
+var (
+	_ datasource.DataSource                   = &AllUserGroupsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AllUserGroupsDataSource{}
+)
 
 func NewAllUserGroupsDataSource() datasource.DataSource {
 	return &AllUserGroupsDataSource{}
@@ -27,8 +33,15 @@ type AllUserGroupsDataSource struct {
 }
 
 type AllUserGroupsDataSourceModel struct {
-	TotalUserGroups types.Int64                        `tfsdk:"total_usergroups"`
-	UserGroups      []AllUserGroupsDataSourceGroupItem `tfsdk:"usergroups"`
+	IncludeDisabled    types.Bool                                  `tfsdk:"include_disabled"`
+	NameRegex          types.String                                `tfsdk:"name_regex"`
+	HandleRegex        types.String                                `tfsdk:"handle_regex"`
+	HasUser            types.String                                `tfsdk:"has_user"`
+	HasChannel         types.String                                `tfsdk:"has_channel"`
+	TotalUserGroups    types.Int64                                 `tfsdk:"total_usergroups"`
+	UserGroups         []AllUserGroupsDataSourceGroupItem          `tfsdk:"usergroups"`
+	UserGroupsByHandle map[string]AllUserGroupsDataSourceGroupItem `tfsdk:"usergroups_by_handle"`
+	UserGroupsByID     map[string]AllUserGroupsDataSourceGroupItem `tfsdk:"usergroups_by_id"`
 }
 
 type AllUserGroupsDataSourceGroupItem struct {
@@ -36,8 +49,11 @@ type AllUserGroupsDataSourceGroupItem struct {
 	Name        types.String   `tfsdk:"name"`
 	Description types.String   `tfsdk:"description"`
 	Handle      types.String   `tfsdk:"handle"`
+	IsEnabled   types.Bool     `tfsdk:"is_enabled"`
+	DateDelete  types.Int64    `tfsdk:"date_delete"`
 	Channels    []types.String `tfsdk:"channels"`
 	Users       []types.String `tfsdk:"users"`
+	UserCount   types.Int64    `tfsdk:"user_count"`
 }
 
 func (d *AllUserGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,55 +62,128 @@ func (d *AllUserGroupsDataSource) Metadata(ctx context.Context, req datasource.M
 
 func (d *AllUserGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Retrieve a list of all Slack user groups.
+		MarkdownDescription: `Retrieve a list of all Slack user groups. By default, disabled user groups are left out.
 
 This datasource requires the following scopes:
 
 - usergroups:read`,
 		Description: "Retrieve all Slack user groups.",
 		Attributes: map[string]schema.Attribute{
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If true, disabled user groups are included. Defaults to false.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include user groups whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"handle_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include user groups whose handle matches this regular expression.",
+				Optional:            true,
+			},
+			"has_user": schema.StringAttribute{
+				MarkdownDescription: "Only include user groups this user ID is a member of.",
+				Optional:            true,
+			},
+			"has_channel": schema.StringAttribute{
+				MarkdownDescription: "Only include user groups sharing this channel ID.",
+				Optional:            true,
+			},
 			"total_usergroups": schema.Int64Attribute{
 				Description: "Total number of user groups retrieved.",
 				Computed:    true,
 			},
 			"usergroups": schema.ListNestedAttribute{
-				Description: "List of Slack user groups.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "User group's Slack ID.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "Name of the user group.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "Description of the user group.",
-							Computed:    true,
-						},
-						"handle": schema.StringAttribute{
-							Description: "Handle of the user group (unique identifier).",
-							Computed:    true,
-						},
-						"channels": schema.ListAttribute{
-							Description: "Channels shared by the user group.",
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-						"users": schema.ListAttribute{
-							Description: "List of user IDs in the user group.",
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-					},
-				},
+				Description:  "List of Slack user groups.",
+				Computed:     true,
+				NestedObject: allUserGroupsItemObject,
+			},
+			"usergroups_by_handle": schema.MapNestedAttribute{
+				Description:  "Slack user groups matching the filters, keyed by handle.",
+				Computed:     true,
+				NestedObject: allUserGroupsItemObject,
+			},
+			"usergroups_by_id": schema.MapNestedAttribute{
+				Description:  "Slack user groups matching the filters, keyed by Slack ID.",
+				Computed:     true,
+				NestedObject: allUserGroupsItemObject,
 			},
 		},
 	}
 }
 
+var allUserGroupsItemObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "User group's Slack ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the user group.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the user group.",
+			Computed:    true,
+		},
+		"handle": schema.StringAttribute{
+			Description: "Handle of the user group (unique identifier).",
+			Computed:    true,
+		},
+		"is_enabled": schema.BoolAttribute{
+			Description: "True if the user group is enabled.",
+			Computed:    true,
+		},
+		"date_delete": schema.Int64Attribute{
+			Description: "UNIX timestamp when the user group was disabled, 0 if it is enabled.",
+			Computed:    true,
+		},
+		"channels": schema.ListAttribute{
+			Description: "Channels shared by the user group.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"users": schema.ListAttribute{
+			Description: "List of user IDs in the user group.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"user_count": schema.Int64Attribute{
+			Description: "Number of users in the user group.",
+			Computed:    true,
+		},
+	},
+}
+
+func (d *AllUserGroupsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config AllUserGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regexes := []struct {
+		name  string
+		value types.String
+	}{
+		{"name_regex", config.NameRegex},
+		{"handle_regex", config.HandleRegex},
+	}
+	for _, r := range regexes {
+		if r.value.IsNull() || r.value.IsUnknown() {
+			continue
+		}
+
+		if _, err := regexp.Compile(r.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(r.name),
+				"Invalid Regular Expression",
+				fmt.Sprintf("'%s' is not a valid regular expression: %s", r.name, err),
+			)
+		}
+	}
+}
+
 func (d *AllUserGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -120,7 +209,35 @@ func (d *AllUserGroupsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	userGroups, err := d.client.GetUserGroups(ctx, slack.GetUserGroupsOptionIncludeUsers(true))
+	// Regular expressions that were unknown in ValidateConfig are compiled for the first time here.
+	var nameRegex, handleRegex *regexp.Regexp
+	var err error
+	if !data.NameRegex.IsNull() {
+		if nameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+			)
+		}
+	}
+	if !data.HandleRegex.IsNull() {
+		if handleRegex, err = regexp.Compile(data.HandleRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("handle_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("'handle_regex' is not a valid regular expression: %s", err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroups, err := d.client.GetUserGroups(ctx,
+		slack.GetUserGroupsOptionIncludeUsers(true),
+		slack.GetUserGroupsOptionIncludeDisabled(data.IncludeDisabled.ValueBool()),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -132,12 +249,21 @@ func (d *AllUserGroupsDataSource) Read(ctx context.Context, req datasource.ReadR
 	tflog.Trace(ctx, "Fetched Slack user groups", map[string]any{"total_usergroups": len(userGroups)})
 
 	var resultingList []AllUserGroupsDataSourceGroupItem
+	userGroupsByHandle := map[string]AllUserGroupsDataSourceGroupItem{}
+	userGroupsByID := map[string]AllUserGroupsDataSourceGroupItem{}
 	for _, group := range userGroups {
+		if !data.matches(&group, nameRegex, handleRegex) {
+			continue
+		}
+
 		groupItem := AllUserGroupsDataSourceGroupItem{
 			ID:          types.StringValue(group.ID),
 			Name:        types.StringValue(group.Name),
 			Description: types.StringValue(group.Description),
 			Handle:      types.StringValue(group.Handle),
+			IsEnabled:   types.BoolValue(group.DateDelete == 0),
+			DateDelete:  types.Int64Value(int64(group.DateDelete)),
+			UserCount:   types.Int64Value(int64(group.UserCount)),
 		}
 
 		channels := make([]types.String, len(group.Prefs.Channels))
@@ -153,10 +279,30 @@ func (d *AllUserGroupsDataSource) Read(ctx context.Context, req datasource.ReadR
 		groupItem.Users = users
 
 		resultingList = append(resultingList, groupItem)
+		userGroupsByHandle[group.Handle] = groupItem
+		userGroupsByID[group.ID] = groupItem
 	}
 
 	data.UserGroups = resultingList
+	data.UserGroupsByHandle = userGroupsByHandle
+	data.UserGroupsByID = userGroupsByID
 	data.TotalUserGroups = types.Int64Value(int64(len(resultingList)))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (m *AllUserGroupsDataSourceModel) matches(group *slack.UserGroup, nameRegex, handleRegex *regexp.Regexp) bool {
+	if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+		return false
+	}
+	if handleRegex != nil && !handleRegex.MatchString(group.Handle) {
+		return false
+	}
+	if !m.HasUser.IsNull() && !slices.Contains(group.Users, m.HasUser.ValueString()) {
+		return false
+	}
+	if !m.HasChannel.IsNull() && !slices.Contains(group.Prefs.Channels, m.HasChannel.ValueString()) {
+		return false
+	}
+	return true
+}
@@ -81,6 +81,113 @@ func Test_DataSource_AllUsergroups(t *testing.T) {
 	})
 }
 
+func Test_DataSource_AllUsergroups_Filters(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			ugA := tb.NewUsergroupBuilder().WithID("<ID_A>").WithName("Team Alpha").WithHandle("team-alpha").WithUsers([]string{"<USER>"}).WithChannels([]string{"<CHANNEL>"}).WithUserCount(1).Build()
+			ugB := tb.NewUsergroupBuilder().WithID("<ID_B>").WithName("Team Beta").WithHandle("team-beta").WithUsers([]string{"<OTHER_USER>"}).WithChannels([]string{"<CHANNEL>"}).Build()
+			ugC := tb.NewUsergroupBuilder().WithID("<ID_C>").WithName("Team Gamma").WithHandle("gamma").WithUsers([]string{"<USER>"}).WithChannels([]string{"<CHANNEL>"}).Build()
+			ugD := tb.NewUsergroupBuilder().WithID("<ID_D>").WithName("Guild Delta").WithHandle("team-delta").WithUsers([]string{"<USER>"}).WithChannels([]string{"<CHANNEL>"}).Build()
+			ugE := tb.NewUsergroupBuilder().WithID("<ID_E>").WithName("Team Epsilon").WithHandle("team-epsilon").WithUsers([]string{"<USER>"}).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return([]slack.UserGroup{*ugA, *ugB, *ugC, *ugD, *ugE}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_all_usergroups" "all_usergroups" {
+				name_regex   = "^Team "
+				handle_regex = "^team-"
+				has_user     = "<USER>"
+				has_channel  = "<CHANNEL>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "total_usergroups", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.0.is_enabled", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.0.user_count", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups_by_handle.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups_by_handle.team-alpha.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups_by_id.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups_by_id.<ID_A>.handle", tb.ExpectString("team-alpha")),
+		),
+	})
+}
+
+func Test_DataSource_AllUsergroups_IncludeDisabled(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			ugA := tb.NewUsergroupBuilder().WithID("<ID_A>").WithHandle("<HANDLE_A>").Build()
+			ugB := tb.NewUsergroupBuilder().WithID("<ID_B>").WithHandle("<HANDLE_B>").WithDateDelete(1700000000).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return([]slack.UserGroup{*ugA, *ugB}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_all_usergroups" "all_usergroups" {
+				include_disabled = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "total_usergroups", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.0.is_enabled", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.0.date_delete", tb.ExpectString("0")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.1.is_enabled", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups.1.date_delete", tb.ExpectString("1700000000")),
+			tr.TestCheckResourceAttrWith("data.slack_all_usergroups.all_usergroups", "usergroups_by_handle.<HANDLE_B>.is_enabled", tb.ExpectBool(false)),
+		),
+	})
+}
+
+func Test_DataSource_AllUsergroups_Error_When_RegexInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_all_usergroups" "all_usergroups" {
+				handle_regex = "team-("
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+	})
+}
+
+func Test_DataSource_AllUsergroups_Error_When_UnknownRegexInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "terraform_data" "pattern" {
+				input = "team-("
+			}
+
+			data "slack_all_usergroups" "all_usergroups" {
+				name_regex   = terraform_data.pattern.output
+				handle_regex = terraform_data.pattern.output
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+	})
+}
+
 func Test_DataSource_AllUsergroups_Error_When_RetrievalFailed(t *testing.T) {
 	testConfig(t, tr.TestStep{
 		// arrange
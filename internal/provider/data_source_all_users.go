@@ -6,16 +6,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/essent/terraform-provider-slack/internal/slackExt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
 )
 
-var _ datasource.DataSource = &AllUsersDataSource{}
+var (
+	_ datasource.DataSource                   = &AllUsersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AllUsersDataSource{}
+)
 
 func NewAllUsersDataSource() datasource.DataSource {
 	return &AllUsersDataSource{}
@@ -26,8 +33,16 @@ type AllUsersDataSource struct {
 }
 
 type AllUsersDataSourceModel struct {
-	Totalusers types.Int64                       `tfsdk:"total_users"`
-	Users      []AllUsersDataSourceModelUserItem `tfsdk:"users"`
+	IncludeBots       types.Bool                                 `tfsdk:"include_bots"`
+	IncludeDeleted    types.Bool                                 `tfsdk:"include_deleted"`
+	IncludeRestricted types.Bool                                 `tfsdk:"include_restricted"`
+	EmailDomain       types.String                               `tfsdk:"email_domain"`
+	IsAdmin           types.Bool                                 `tfsdk:"is_admin"`
+	NameRegex         types.String                               `tfsdk:"name_regex"`
+	Totalusers        types.Int64                                `tfsdk:"total_users"`
+	Users             []AllUsersDataSourceModelUserItem          `tfsdk:"users"`
+	UsersByEmail      map[string]AllUsersDataSourceModelUserItem `tfsdk:"users_by_email"`
+	UsersByID         map[string]AllUsersDataSourceModelUserItem `tfsdk:"users_by_id"`
 }
 
 type AllUsersDataSourceModelUserItem struct {
@@ -42,40 +57,96 @@ func (d *AllUsersDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *AllUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Retrieve a list of all activated and non-bot Slack users.
+		MarkdownDescription: `Retrieve a list of Slack users. By default, deactivated users and bots are left out.
 
 This datasource requires the following scopes:
 
 - users:read`,
 		Attributes: map[string]schema.Attribute{
+			"include_bots": schema.BoolAttribute{
+				MarkdownDescription: "If true, bots are included. Defaults to false.",
+				Optional:            true,
+			},
+			"include_deleted": schema.BoolAttribute{
+				MarkdownDescription: "If true, deactivated users are included. Defaults to false.",
+				Optional:            true,
+			},
+			"include_restricted": schema.BoolAttribute{
+				MarkdownDescription: "If false, guests (restricted and ultra restricted users) are left out. Defaults to true.",
+				Optional:            true,
+			},
+			"email_domain": schema.StringAttribute{
+				MarkdownDescription: "Only include users whose email address belongs to this domain, e.g. `example.com`.",
+				Optional:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "If set, only include users whose admin status matches.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include users whose name matches this regular expression.",
+				Optional:            true,
+			},
 			"total_users": schema.Int64Attribute{
 				Description: "Number of users returned.",
 				Computed:    true,
 			},
 			"users": schema.ListNestedAttribute{
-				Description: "List of activated and non-bot Slack users.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "User's Slack ID.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "User's name.",
-							Computed:    true,
-						},
-						"email": schema.StringAttribute{
-							Description: "User's email address.",
-							Computed:    true,
-						},
-					},
-				},
+				Description:  "List of Slack users matching the filters.",
+				Computed:     true,
+				NestedObject: allUsersItemObject,
+			},
+			"users_by_email": schema.MapNestedAttribute{
+				Description:  "Slack users matching the filters, keyed by email address. Users without an email address are left out. When several users share an email address, the last one in `users` wins.",
+				Computed:     true,
+				NestedObject: allUsersItemObject,
+			},
+			"users_by_id": schema.MapNestedAttribute{
+				Description:  "Slack users matching the filters, keyed by Slack ID.",
+				Computed:     true,
+				NestedObject: allUsersItemObject,
 			},
 		},
 	}
 }
 
+var allUsersItemObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "User's Slack ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "User's name.",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "User's email address.",
+			Computed:    true,
+		},
+	},
+}
+
+func (d *AllUsersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config AllUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.NameRegex.IsNull() || config.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+		)
+	}
+}
+
 func (d *AllUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -99,6 +170,21 @@ func (d *AllUsersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// A name_regex that is unknown during validation is only checked here.
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
 	users, err := d.client.GetUsersContext(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -111,18 +197,58 @@ func (d *AllUsersDataSource) Read(ctx context.Context, req datasource.ReadReques
 	tflog.Trace(ctx, "Fetched Slack users", map[string]any{"total_users": len(users)})
 
 	var resultingList []AllUsersDataSourceModelUserItem
+	usersByEmail := map[string]AllUsersDataSourceModelUserItem{}
+	usersByID := map[string]AllUsersDataSourceModelUserItem{}
 	for _, user := range users {
-		if !user.Deleted && !user.IsBot {
-			resultingList = append(resultingList, AllUsersDataSourceModelUserItem{
-				ID:    types.StringValue(user.ID),
-				Name:  types.StringValue(user.Name),
-				Email: types.StringValue(user.Profile.Email),
-			})
+		if !data.matches(&user, nameRegex) {
+			continue
+		}
+
+		item := AllUsersDataSourceModelUserItem{
+			ID:    types.StringValue(user.ID),
+			Name:  types.StringValue(user.Name),
+			Email: types.StringValue(user.Profile.Email),
+		}
+		resultingList = append(resultingList, item)
+		usersByID[user.ID] = item
+		if user.Profile.Email != "" {
+			if previous, ok := usersByEmail[user.Profile.Email]; ok {
+				tflog.Warn(ctx, "Several Slack users share an email address; keeping the last one in users_by_email", map[string]any{
+					"email":    user.Profile.Email,
+					"replaced": previous.ID.ValueString(),
+					"kept":     user.ID,
+				})
+			}
+			usersByEmail[user.Profile.Email] = item
 		}
 	}
 
 	data.Users = resultingList
+	data.UsersByEmail = usersByEmail
+	data.UsersByID = usersByID
 	data.Totalusers = types.Int64Value(int64(len(resultingList)))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (m *AllUsersDataSourceModel) matches(user *slack.User, nameRegex *regexp.Regexp) bool {
+	if user.Deleted && !m.IncludeDeleted.ValueBool() {
+		return false
+	}
+	if user.IsBot && !m.IncludeBots.ValueBool() {
+		return false
+	}
+	if (user.IsRestricted || user.IsUltraRestricted) && !m.IncludeRestricted.IsNull() && !m.IncludeRestricted.ValueBool() {
+		return false
+	}
+	if !m.EmailDomain.IsNull() && !strings.HasSuffix(strings.ToLower(user.Profile.Email), "@"+strings.ToLower(m.EmailDomain.ValueString())) {
+		return false
+	}
+	if !m.IsAdmin.IsNull() && user.IsAdmin != m.IsAdmin.ValueBool() {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(user.Name) {
+		return false
+	}
+	return true
+}
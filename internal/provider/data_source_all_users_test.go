@@ -50,6 +50,74 @@ func Test_DataSource_AllUsers(t *testing.T) {
 	})
 }
 
+func Test_DataSource_AllUsers_Filters(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithName("alice").WithEmail("alice@example.com").WithIsAdmin(true).Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithName("bob").WithEmail("bob@example.com").Build()
+			uC := tb.NewUserBuilder().WithID("<ID_C>").WithName("carol").WithEmail("carol@other.com").WithIsAdmin(true).Build()
+			uD := tb.NewUserBuilder().WithID("<ID_D>").WithName("dave").WithEmail("dave@example.com").WithIsAdmin(true).WithIsRestricted(true).Build()
+			uE := tb.NewUserBuilder().WithID("<ID_E>").WithName("erin").WithEmail("erin@example.com").WithIsAdmin(true).WithDeleted(true).Build()
+			uF := tb.NewUserBuilder().WithID("<ID_F>").WithName("anna-bot").WithIsAdmin(true).WithIsBot(true).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUsersContext(gomock.Any()).Return([]slack.User{*uA, *uB, *uC, *uD, *uE, *uF}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_all_users" "admins" {
+				include_deleted    = true
+				include_restricted = false
+				email_domain       = "EXAMPLE.com"
+				is_admin           = true
+				name_regex         = "^[a-e]"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "total_users", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users.1.id", tb.ExpectString("<ID_E>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users_by_email.alice@example.com.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users_by_email.erin@example.com.id", tb.ExpectString("<ID_E>")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users_by_id.<ID_A>.name", tb.ExpectString("alice")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.admins", "users_by_id.<ID_E>.email", tb.ExpectString("erin@example.com")),
+		),
+	})
+}
+
+func Test_DataSource_AllUsers_When_EmailShared(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithName("alice").WithEmail("alice@example.com").Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithName("bob").Build()
+			uC := tb.NewUserBuilder().WithID("<ID_C>").WithName("alice-new").WithEmail("alice@example.com").Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUsersContext(gomock.Any()).Return([]slack.User{*uA, *uB, *uC}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_all_users" "all_users" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_all_users.all_users", "total_users", tb.ExpectString("3")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.all_users", "users_by_id.%", tb.ExpectString("3")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.all_users", "users_by_email.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_all_users.all_users", "users_by_email.alice@example.com.id", tb.ExpectString("<ID_C>")),
+		),
+	})
+}
+
 func Test_DataSource_AllUsers_Error_When_RetrievalFailed(t *testing.T) {
 	testConfig(t, tr.TestStep{
 		// arrange
@@ -69,6 +137,26 @@ func Test_DataSource_AllUsers_Error_When_RetrievalFailed(t *testing.T) {
 	})
 }
 
+func Test_DataSource_AllUsers_Error_When_UnknownRegexInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "terraform_data" "pattern" {
+				input = "user-("
+			}
+
+			data "slack_all_users" "all_users" {
+				name_regex = terraform_data.pattern.output
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+	})
+}
+
 func Test_DataSource_AllUsers_Error_WhenSlackClientNil(t *testing.T) {
 	// arrange
 	res := &datasource.ConfigureResponse{}
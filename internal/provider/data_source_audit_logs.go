@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultAuditLogsMaxEntries = 1000
+
+var (
+	_ datasource.DataSource                   = &AuditLogsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AuditLogsDataSource{}
+)
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+type AuditLogsDataSource struct {
+	queries slackExt.Queries
+}
+
+type AuditLogsDataSourceModel struct {
+	Action       types.String                        `tfsdk:"action"`
+	Actor        types.String                        `tfsdk:"actor"`
+	Entity       types.String                        `tfsdk:"entity"`
+	Oldest       types.Int64                         `tfsdk:"oldest"`
+	Latest       types.Int64                         `tfsdk:"latest"`
+	Lookback     types.String                        `tfsdk:"lookback"`
+	MaxEntries   types.Int64                         `tfsdk:"max_entries"`
+	TotalEntries types.Int64                         `tfsdk:"total_entries"`
+	Entries      []AuditLogsDataSourceModelEntryItem `tfsdk:"entries"`
+}
+
+type AuditLogsDataSourceModelEntryItem struct {
+	ID           types.String `tfsdk:"id"`
+	DateCreate   types.Int64  `tfsdk:"date_create"`
+	Action       types.String `tfsdk:"action"`
+	ActorType    types.String `tfsdk:"actor_type"`
+	ActorID      types.String `tfsdk:"actor_id"`
+	ActorName    types.String `tfsdk:"actor_name"`
+	ActorEmail   types.String `tfsdk:"actor_email"`
+	EntityType   types.String `tfsdk:"entity_type"`
+	EntityID     types.String `tfsdk:"entity_id"`
+	EntityName   types.String `tfsdk:"entity_name"`
+	LocationType types.String `tfsdk:"location_type"`
+	LocationID   types.String `tfsdk:"location_id"`
+	LocationName types.String `tfsdk:"location_name"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	UserAgent    types.String `tfsdk:"user_agent"`
+	Details      types.String `tfsdk:"details"`
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve entries from the Enterprise Grid Audit Logs API, newest first.
+
+This datasource requires an org-level user token with the following scopes:
+
+- auditlogs:read`,
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only include entries with this action, e.g. `subteam_updated`. Several actions can be separated by commas.",
+				Optional:            true,
+			},
+			"actor": schema.StringAttribute{
+				MarkdownDescription: "Only include entries where this user ID is the actor.",
+				Optional:            true,
+			},
+			"entity": schema.StringAttribute{
+				MarkdownDescription: "Only include entries about the entity with this ID, e.g. a user group, channel or user ID.",
+				Optional:            true,
+			},
+			"oldest": schema.Int64Attribute{
+				MarkdownDescription: "Only include entries created at or after this UNIX timestamp.",
+				Optional:            true,
+			},
+			"latest": schema.Int64Attribute{
+				MarkdownDescription: "Only include entries created at or before this UNIX timestamp.",
+				Optional:            true,
+			},
+			"lookback": schema.StringAttribute{
+				MarkdownDescription: "Only include entries created within this duration before now, e.g. `24h`. Conflicts with `oldest`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("oldest")),
+				},
+			},
+			"max_entries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of entries to return. Defaults to %d; 0 returns all matching entries.", defaultAuditLogsMaxEntries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"total_entries": schema.Int64Attribute{
+				Description: "Number of entries returned.",
+				Computed:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "List of audit log entries matching the filters, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Entry's ID.",
+							Computed:    true,
+						},
+						"date_create": schema.Int64Attribute{
+							Description: "UNIX timestamp when the action happened.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action that happened, e.g. subteam_updated.",
+							Computed:    true,
+						},
+						"actor_type": schema.StringAttribute{
+							Description: "Type of the actor, usually user.",
+							Computed:    true,
+						},
+						"actor_id": schema.StringAttribute{
+							Description: "ID of the actor.",
+							Computed:    true,
+						},
+						"actor_name": schema.StringAttribute{
+							Description: "Name of the actor.",
+							Computed:    true,
+						},
+						"actor_email": schema.StringAttribute{
+							Description: "Email address of the actor.",
+							Computed:    true,
+						},
+						"entity_type": schema.StringAttribute{
+							Description: "Type of the entity the action was performed on, e.g. user, channel or usergroup.",
+							Computed:    true,
+						},
+						"entity_id": schema.StringAttribute{
+							Description: "ID of the entity the action was performed on.",
+							Computed:    true,
+						},
+						"entity_name": schema.StringAttribute{
+							Description: "Name of the entity the action was performed on.",
+							Computed:    true,
+						},
+						"location_type": schema.StringAttribute{
+							Description: "Type of the location the action happened in, i.e. workspace or enterprise.",
+							Computed:    true,
+						},
+						"location_id": schema.StringAttribute{
+							Description: "ID of the location the action happened in.",
+							Computed:    true,
+						},
+						"location_name": schema.StringAttribute{
+							Description: "Name of the location the action happened in.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address the action was performed from.",
+							Computed:    true,
+						},
+						"user_agent": schema.StringAttribute{
+							Description: "User agent the action was performed with.",
+							Computed:    true,
+						},
+						"details": schema.StringAttribute{
+							Description: "JSON encoded details specific to the action. Empty if the entry has no details.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config AuditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Lookback.IsNull() || config.Lookback.IsUnknown() {
+		return
+	}
+
+	if lookback, err := time.ParseDuration(config.Lookback.ValueString()); err != nil || lookback <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lookback"),
+			"Invalid Duration",
+			fmt.Sprintf("'lookback' must be a positive duration such as 24h, got: %q", config.Lookback.ValueString()),
+		)
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := slackExt.AuditLogsParams{
+		Action: data.Action.ValueString(),
+		Actor:  data.Actor.ValueString(),
+		Entity: data.Entity.ValueString(),
+		Oldest: data.Oldest.ValueInt64(),
+		Latest: data.Latest.ValueInt64(),
+	}
+	if !data.Lookback.IsNull() {
+		lookback, _ := time.ParseDuration(data.Lookback.ValueString())
+		params.Oldest = time.Now().Add(-lookback).Unix()
+	}
+
+	maxEntries := defaultAuditLogsMaxEntries
+	if !data.MaxEntries.IsNull() {
+		maxEntries = int(data.MaxEntries.ValueInt64())
+	}
+
+	entries, err := d.queries.GetAllAuditLogs(ctx, params, maxEntries)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch audit logs: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack audit logs", map[string]any{"total_entries": len(entries)})
+
+	resultingList := []AuditLogsDataSourceModelEntryItem{}
+	for _, entry := range entries {
+		details := string(entry.Details)
+		if details == "null" {
+			details = ""
+		}
+
+		resultingList = append(resultingList, AuditLogsDataSourceModelEntryItem{
+			ID:           types.StringValue(entry.ID),
+			DateCreate:   types.Int64Value(entry.DateCreate),
+			Action:       types.StringValue(entry.Action),
+			ActorType:    types.StringValue(entry.Actor.Type),
+			ActorID:      types.StringValue(entry.Actor.ID),
+			ActorName:    types.StringValue(entry.Actor.Name),
+			ActorEmail:   types.StringValue(entry.Actor.Email),
+			EntityType:   types.StringValue(entry.Entity.Type),
+			EntityID:     types.StringValue(entry.Entity.ID),
+			EntityName:   types.StringValue(entry.Entity.Name),
+			LocationType: types.StringValue(entry.Context.Location.Type),
+			LocationID:   types.StringValue(entry.Context.Location.ID),
+			LocationName: types.StringValue(entry.Context.Location.Name),
+			IPAddress:    types.StringValue(entry.Context.IPAddress),
+			UserAgent:    types.StringValue(entry.Context.UA),
+			Details:      types.StringValue(details),
+		})
+	}
+
+	data.Entries = resultingList
+	data.TotalEntries = types.Int64Value(int64(len(resultingList)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
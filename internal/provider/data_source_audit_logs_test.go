@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_AuditLogs(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			entries := []slackExt.AuditEntry{
+				{
+					ID:         "<ENTRY_ID>",
+					DateCreate: 1700000000,
+					Action:     "subteam_updated",
+					Actor:      slackExt.AuditObject{Type: "user", ID: "<USER_ID>", Name: "<USER_NAME>", Email: "<USER_EMAIL>"},
+					Entity:     slackExt.AuditObject{Type: "usergroup", ID: "<USERGROUP_ID>", Name: "<USERGROUP_NAME>"},
+					Context: slackExt.AuditContext{
+						Location:  slackExt.AuditLocation{Type: "workspace", ID: "<TEAM_ID>", Name: "<TEAM_NAME>"},
+						UA:        "<USER_AGENT>",
+						IPAddress: "<IP_ADDRESS>",
+					},
+					Details: json.RawMessage(`{"new_value":"<VALUE>"}`),
+				},
+				{ID: "<OTHER_ENTRY_ID>", Action: "user_login", Actor: slackExt.AuditObject{Type: "user", ID: "<USER_ID>"}},
+			}
+			expected_audit_params := slackExt.AuditLogsParams{Action: "subteam_updated,user_login", Actor: "<USER_ID>", Oldest: 1690000000, Latest: 1710000000}
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllAuditLogs(gomock.Any(), expected_audit_params, 1000).Return(entries, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_audit_logs" "logs" {
+				action = "subteam_updated,user_login"
+				actor  = "<USER_ID>"
+				oldest = 1690000000
+				latest = 1710000000
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "total_entries", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.id", tb.ExpectString("<ENTRY_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.date_create", tb.ExpectString("1700000000")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.action", tb.ExpectString("subteam_updated")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.actor_type", tb.ExpectString("user")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.actor_id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.actor_name", tb.ExpectString("<USER_NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.actor_email", tb.ExpectString("<USER_EMAIL>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.entity_type", tb.ExpectString("usergroup")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.entity_id", tb.ExpectString("<USERGROUP_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.entity_name", tb.ExpectString("<USERGROUP_NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.location_type", tb.ExpectString("workspace")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.location_id", tb.ExpectString("<TEAM_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.location_name", tb.ExpectString("<TEAM_NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.ip_address", tb.ExpectString("<IP_ADDRESS>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.user_agent", tb.ExpectString("<USER_AGENT>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.0.details", tb.ExpectString(`{"new_value":"<VALUE>"}`)),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.1.id", tb.ExpectString("<OTHER_ENTRY_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "entries.1.details", tb.ExpectString("")),
+		),
+	})
+}
+
+func Test_DataSource_AuditLogs_Lookback(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			expected_oldest := time.Now().Add(-24 * time.Hour).Unix()
+			expected_audit_params := gomock.Cond(func(params slackExt.AuditLogsParams) bool {
+				return params.Entity == "<USERGROUP_ID>" && params.Oldest >= expected_oldest && params.Oldest <= expected_oldest+60
+			})
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllAuditLogs(gomock.Any(), expected_audit_params, 0).Return([]slackExt.AuditEntry{}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_audit_logs" "logs" {
+				entity      = "<USERGROUP_ID>"
+				lookback    = "24h"
+				max_entries = 0
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_audit_logs.logs", "total_entries", tb.ExpectString("0")),
+		),
+	})
+}
+
+func Test_DataSource_AuditLogs_Error_When_LookbackInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_audit_logs" "logs" {
+				lookback = "1 day"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Duration"),
+	})
+}
+
+func Test_DataSource_AuditLogs_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllAuditLogs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_audit_logs" "logs" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_AuditLogs_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := AuditLogsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
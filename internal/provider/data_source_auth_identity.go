@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AuthIdentityDataSource{}
+
+func NewAuthIdentityDataSource() datasource.DataSource {
+	return &AuthIdentityDataSource{}
+}
+
+type AuthIdentityDataSource struct {
+	identity *slackExt.AuthIdentity
+}
+
+type AuthIdentityDataSourceModel struct {
+	UserID              types.String `tfsdk:"user_id"`
+	User                types.String `tfsdk:"user"`
+	BotID               types.String `tfsdk:"bot_id"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Team                types.String `tfsdk:"team"`
+	URL                 types.String `tfsdk:"url"`
+	EnterpriseID        types.String `tfsdk:"enterprise_id"`
+	IsEnterpriseInstall types.Bool   `tfsdk:"is_enterprise_install"`
+	Scopes              types.Set    `tfsdk:"scopes"`
+}
+
+func (d *AuthIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_identity"
+}
+
+func (d *AuthIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the identity the provider is authenticated as, and the scopes granted to its token.
+
+The identity is the one the provider authenticated with when it was configured, so reading it does not call Slack again.
+
+This datasource does not require any scopes.`,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the authenticated user. For bot tokens, this is the bot user.",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Name of the authenticated user.",
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the bot. Empty for user tokens.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace the token belongs to.",
+				Computed:            true,
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Name of the workspace the token belongs to.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the workspace.",
+				Computed:            true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Enterprise Grid organization. Empty outside of Enterprise Grid.",
+				Computed:            true,
+			},
+			"is_enterprise_install": schema.BoolAttribute{
+				MarkdownDescription: "True if the app is installed on the whole Enterprise Grid organization.",
+				Computed:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the token.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AuthIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Identity == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized identity, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.identity = providerData.Identity
+}
+
+func (d *AuthIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuthIdentityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := d.identity
+	data.UserID = types.StringValue(identity.UserID)
+	data.User = types.StringValue(identity.User)
+	data.BotID = types.StringValue(identity.BotID)
+	data.TeamID = types.StringValue(identity.TeamID)
+	data.Team = types.StringValue(identity.Team)
+	data.URL = types.StringValue(identity.URL)
+	data.EnterpriseID = types.StringValue(identity.EnterpriseID)
+	data.IsEnterpriseInstall = types.BoolValue(identity.IsEnterpriseInstall)
+	data.Scopes = stringSliceToSet(identity.Scopes)
+
+	tflog.Trace(ctx, "Read Slack auth identity", map[string]any{"user_id": identity.UserID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
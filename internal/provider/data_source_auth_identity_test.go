@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func Test_DataSource_AuthIdentity(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			*tb.MockAuthIdentity() = slackExt.AuthIdentity{
+				URL:                 "<URL>",
+				Team:                "<TEAM>",
+				User:                "<USER>",
+				TeamID:              "<TEAM_ID>",
+				UserID:              "<USER_ID>",
+				BotID:               "<BOT_ID>",
+				EnterpriseID:        "<ENTERPRISE_ID>",
+				IsEnterpriseInstall: true,
+				Scopes:              []string{"users:read", "chat:write"},
+			}
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_auth_identity" "me" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "url", tb.ExpectString("<URL>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "team", tb.ExpectString("<TEAM>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "user", tb.ExpectString("<USER>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "team_id", tb.ExpectString("<TEAM_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "user_id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "bot_id", tb.ExpectString("<BOT_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "enterprise_id", tb.ExpectString("<ENTERPRISE_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_auth_identity.me", "is_enterprise_install", tb.ExpectBool(true)),
+			tr.TestCheckTypeSetElemAttr("data.slack_auth_identity.me", "scopes.*", "users:read"),
+			tr.TestCheckTypeSetElemAttr("data.slack_auth_identity.me", "scopes.*", "chat:write"),
+		),
+	})
+}
+
+func Test_DataSource_AuthIdentity_Error_WhenIdentityNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Identity: nil,
+		},
+	}
+
+	test_instance := AuthIdentityDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
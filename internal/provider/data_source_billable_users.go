@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &BillableUsersDataSource{}
+
+func NewBillableUsersDataSource() datasource.DataSource {
+	return &BillableUsersDataSource{}
+}
+
+type BillableUsersDataSource struct {
+	client  slackExt.Client
+	queries slackExt.Queries
+}
+
+type BillableUsersDataSourceModel struct {
+	TeamID           types.String                           `tfsdk:"team_id"`
+	BillingActive    types.Bool                             `tfsdk:"billing_active"`
+	TotalUsers       types.Int64                            `tfsdk:"total_users"`
+	TotalBillable    types.Int64                            `tfsdk:"total_billable"`
+	TotalNonBillable types.Int64                            `tfsdk:"total_non_billable"`
+	Users            []BillableUsersDataSourceModelUserItem `tfsdk:"users"`
+}
+
+type BillableUsersDataSourceModelUserItem struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Email         types.String `tfsdk:"email"`
+	Deleted       types.Bool   `tfsdk:"deleted"`
+	BillingActive types.Bool   `tfsdk:"billing_active"`
+}
+
+func (d *BillableUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billable_users"
+}
+
+func (d *BillableUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the billing status of the users in a workspace, together with their name and email.
+
+This datasource requires a user token with the following scopes:
+
+- admin
+- users:read
+- users:read.email`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to retrieve the billing status for. Required for org-level tokens.",
+				Optional:            true,
+			},
+			"billing_active": schema.BoolAttribute{
+				MarkdownDescription: "If set, only users with this billing status are included in `users`. The totals always cover all users.",
+				Optional:            true,
+			},
+			"total_users": schema.Int64Attribute{
+				Description: "Number of users the billing status is known of.",
+				Computed:    true,
+			},
+			"total_billable": schema.Int64Attribute{
+				Description: "Number of users that are billed.",
+				Computed:    true,
+			},
+			"total_non_billable": schema.Int64Attribute{
+				Description: "Number of users that are not billed.",
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "List of users with their billing status, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "User's Slack ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "User's Slack name.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "User's email address.",
+							Computed:    true,
+						},
+						"deleted": schema.BoolAttribute{
+							Description: "True if the user is deactivated.",
+							Computed:    true,
+						},
+						"billing_active": schema.BoolAttribute{
+							Description: "True if the user is billed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BillableUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.queries = providerData.Queries
+}
+
+func (d *BillableUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BillableUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	billingActive, err := d.queries.GetAllBillableInfo(ctx, data.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch billable info: %s", err),
+		)
+		return
+	}
+
+	users, err := d.client.GetUsersContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch Slack users: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack billable info", map[string]any{"billable_info": len(billingActive), "users": len(users)})
+
+	usersByID := make(map[string]slack.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	userIDs := make([]string, 0, len(billingActive))
+	for userID := range billingActive {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	var totalBillable int64
+	resultingList := []BillableUsersDataSourceModelUserItem{}
+	for _, userID := range userIDs {
+		active := billingActive[userID]
+		if active {
+			totalBillable++
+		}
+		if !data.BillingActive.IsNull() && data.BillingActive.ValueBool() != active {
+			continue
+		}
+
+		user := usersByID[userID]
+		resultingList = append(resultingList, BillableUsersDataSourceModelUserItem{
+			ID:            types.StringValue(userID),
+			Name:          types.StringValue(user.Name),
+			Email:         types.StringValue(user.Profile.Email),
+			Deleted:       types.BoolValue(user.Deleted),
+			BillingActive: types.BoolValue(active),
+		})
+	}
+
+	data.Users = resultingList
+	data.TotalUsers = types.Int64Value(int64(len(userIDs)))
+	data.TotalBillable = types.Int64Value(totalBillable)
+	data.TotalNonBillable = types.Int64Value(int64(len(userIDs)) - totalBillable)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
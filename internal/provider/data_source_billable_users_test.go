@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_BillableUsers(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithName("<NAME_A>").WithEmail("<EMAIL_A>").Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithName("<NAME_B>").WithEmail("<EMAIL_B>").WithDeleted(true).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUsersContext(gomock.Any()).Return([]slack.User{*uA, *uB}, nil).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllBillableInfo(gomock.Any(), "").Return(map[string]bool{"<ID_B>": false, "<ID_A>": true}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_billable_users" "billing" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "total_users", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "total_billable", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "total_non_billable", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.#", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.name", tb.ExpectString("<NAME_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.email", tb.ExpectString("<EMAIL_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.billing_active", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.1.id", tb.ExpectString("<ID_B>")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.1.deleted", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.1.billing_active", tb.ExpectBool(false)),
+		),
+	})
+}
+
+func Test_DataSource_BillableUsers_FilterBillingActive(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithName("<NAME_A>").Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithName("<NAME_B>").Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUsersContext(gomock.Any()).Return([]slack.User{*uA, *uB}, nil).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllBillableInfo(gomock.Any(), "<TEAM_ID>").Return(map[string]bool{"<ID_A>": true, "<ID_B>": false}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_billable_users" "billing" {
+				team_id        = "<TEAM_ID>"
+				billing_active = false
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "total_users", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.#", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.id", tb.ExpectString("<ID_B>")),
+			tr.TestCheckResourceAttrWith("data.slack_billable_users.billing", "users.0.name", tb.ExpectString("<NAME_B>")),
+		),
+	})
+}
+
+func Test_DataSource_BillableUsers_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllBillableInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_billable_users" "billing" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_BillableUsers_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := BillableUsersDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &BotDataSource{}
+
+func NewBotDataSource() datasource.DataSource {
+	return &BotDataSource{}
+}
+
+type BotDataSource struct {
+	client slackExt.Client
+}
+
+type BotDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	UserID  types.String `tfsdk:"user_id"`
+	TeamID  types.String `tfsdk:"team_id"`
+	Name    types.String `tfsdk:"name"`
+	AppID   types.String `tfsdk:"app_id"`
+	Deleted types.Bool   `tfsdk:"deleted"`
+	Updated types.Int64  `tfsdk:"updated"`
+	Icon36  types.String `tfsdk:"icon_36"`
+	Icon48  types.String `tfsdk:"icon_48"`
+	Icon72  types.String `tfsdk:"icon_72"`
+}
+
+func (d *BotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bot"
+}
+
+func (d *BotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve a Slack bot by its bot ID or by the ID of its bot user. Exactly one of 'id' or 'user_id' must be specified.
+
+This datasource requires the following scopes:
+
+- users:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Bot ID to look up, e.g. `B0123456789`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("user_id"),
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the bot user to look up, e.g. `U0123456789`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace the bot belongs to. Required for org-level tokens.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bot.",
+				Computed:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the app the bot belongs to.",
+				Computed:            true,
+			},
+			"deleted": schema.BoolAttribute{
+				MarkdownDescription: "True if the bot is deleted.",
+				Computed:            true,
+			},
+			"updated": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the bot was last updated.",
+				Computed:            true,
+			},
+			"icon_36": schema.StringAttribute{
+				MarkdownDescription: "URL of the 36x36 bot icon.",
+				Computed:            true,
+			},
+			"icon_48": schema.StringAttribute{
+				MarkdownDescription: "URL of the 48x48 bot icon.",
+				Computed:            true,
+			},
+			"icon_72": schema.StringAttribute{
+				MarkdownDescription: "URL of the 72x72 bot icon.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *BotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	botID := data.ID.ValueString()
+	if !data.UserID.IsNull() {
+		user, err := d.client.GetUserInfo(ctx, data.UserID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to fetch bot user %s: %s", data.UserID.ValueString(), err),
+			)
+			return
+		}
+		if user.Profile.BotID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user_id"),
+				"Not a Bot User",
+				fmt.Sprintf("User %s is not a bot user.", data.UserID.ValueString()),
+			)
+			return
+		}
+		botID = user.Profile.BotID
+	}
+
+	bot, err := d.client.GetBotInfo(ctx, slack.GetBotInfoParameters{
+		Bot:    botID,
+		TeamID: data.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch bot %s: %s", botID, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(bot.ID)
+	data.UserID = types.StringValue(bot.UserID)
+	data.Name = types.StringValue(bot.Name)
+	data.AppID = types.StringValue(bot.AppID)
+	data.Deleted = types.BoolValue(bot.Deleted)
+	data.Updated = types.Int64Value(int64(bot.Updated))
+	data.Icon36 = types.StringValue(bot.Icons.Image36)
+	data.Icon48 = types.StringValue(bot.Icons.Image48)
+	data.Icon72 = types.StringValue(bot.Icons.Image72)
+
+	tflog.Trace(ctx, "Fetched Slack bot", map[string]any{"id": bot.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func testBot() *slack.Bot {
+	return &slack.Bot{
+		ID:      "<BOT_ID>",
+		Name:    "<NAME>",
+		UserID:  "<USER_ID>",
+		AppID:   "<APP_ID>",
+		Updated: 1700000000,
+		Icons:   slack.Icons{Image36: "<ICON_36>", Image72: "<ICON_72>"},
+	}
+}
+
+func Test_DataSource_Bot(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			expected_bot_params := slack.GetBotInfoParameters{Bot: "<BOT_ID>"}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetBotInfo(gomock.Any(), expected_bot_params).Return(testBot(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_bot" "bot" {
+				id = "<BOT_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "id", tb.ExpectString("<BOT_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "user_id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "name", tb.ExpectString("<NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "app_id", tb.ExpectString("<APP_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "deleted", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "updated", tb.ExpectString("1700000000")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "icon_36", tb.ExpectString("<ICON_36>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "icon_72", tb.ExpectString("<ICON_72>")),
+		),
+	})
+}
+
+func Test_DataSource_Bot_ByUserID(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			user := tb.NewUserBuilder().WithID("<USER_ID>").WithIsBot(true).WithBotID("<BOT_ID>").Build()
+			expected_bot_params := slack.GetBotInfoParameters{Bot: "<BOT_ID>", TeamID: "<TEAM_ID>"}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserInfo(gomock.Any(), "<USER_ID>").Return(user, nil).AnyTimes()
+			m.EXPECT().GetBotInfo(gomock.Any(), expected_bot_params).Return(testBot(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_bot" "bot" {
+				user_id = "<USER_ID>"
+				team_id = "<TEAM_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "id", tb.ExpectString("<BOT_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_bot.bot", "app_id", tb.ExpectString("<APP_ID>")),
+		),
+	})
+}
+
+func Test_DataSource_Bot_Error_When_UserIsNoBot(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			user := tb.NewUserBuilder().WithID("<USER_ID>").Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserInfo(gomock.Any(), "<USER_ID>").Return(user, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_bot" "bot" {
+				user_id = "<USER_ID>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("is not a bot user"),
+	})
+}
+
+func Test_DataSource_Bot_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetBotInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_bot" "bot" {
+				id = "<BOT_ID>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Bot_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := BotDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -9,8 +9,11 @@ import (
 
 	"github.com/essent/terraform-provider-slack/internal/slackExt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/slack-go/slack"
@@ -23,20 +26,36 @@ func NewConversationDataSource() datasource.DataSource {
 }
 
 type ConversationDataSource struct {
-	client slackExt.Client
+	client  slackExt.Client
+	queries slackExt.Queries
 }
 
 type ConversationDataSourceModel struct {
-	ChannelID   types.String `tfsdk:"channel_id"`
-	Topic       types.String `tfsdk:"topic"`
-	Purpose     types.String `tfsdk:"purpose"`
-	Created     types.Int64  `tfsdk:"created"`
-	Creator     types.String `tfsdk:"creator"`
-	IsArchived  types.Bool   `tfsdk:"is_archived"`
-	IsShared    types.Bool   `tfsdk:"is_shared"`
-	IsExtShared types.Bool   `tfsdk:"is_ext_shared"`
-	IsOrgShared types.Bool   `tfsdk:"is_org_shared"`
-	IsGeneral   types.Bool   `tfsdk:"is_general"`
+	ChannelID         types.String `tfsdk:"channel_id"`
+	Name              types.String `tfsdk:"name"`
+	IncludeNumMembers types.Bool   `tfsdk:"include_num_members"`
+	IncludeLocale     types.Bool   `tfsdk:"include_locale"`
+	NameNormalized    types.String `tfsdk:"name_normalized"`
+	Topic             types.String `tfsdk:"topic"`
+	TopicCreator      types.String `tfsdk:"topic_creator"`
+	TopicLastSet      types.Int64  `tfsdk:"topic_last_set"`
+	Purpose           types.String `tfsdk:"purpose"`
+	PurposeCreator    types.String `tfsdk:"purpose_creator"`
+	PurposeLastSet    types.Int64  `tfsdk:"purpose_last_set"`
+	Created           types.Int64  `tfsdk:"created"`
+	Creator           types.String `tfsdk:"creator"`
+	IsArchived        types.Bool   `tfsdk:"is_archived"`
+	IsShared          types.Bool   `tfsdk:"is_shared"`
+	IsExtShared       types.Bool   `tfsdk:"is_ext_shared"`
+	IsOrgShared       types.Bool   `tfsdk:"is_org_shared"`
+	IsGeneral         types.Bool   `tfsdk:"is_general"`
+	IsPrivate         types.Bool   `tfsdk:"is_private"`
+	IsChannel         types.Bool   `tfsdk:"is_channel"`
+	IsIM              types.Bool   `tfsdk:"is_im"`
+	IsMpIM            types.Bool   `tfsdk:"is_mpim"`
+	NumMembers        types.Int64  `tfsdk:"num_members"`
+	Locale            types.String `tfsdk:"locale"`
+	ConnectedTeamIDs  types.List   `tfsdk:"connected_team_ids"`
 }
 
 func (d *ConversationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,7 +64,9 @@ func (d *ConversationDataSource) Metadata(ctx context.Context, req datasource.Me
 
 func (d *ConversationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Retrieve information about a Slack conversation by its ID.
+		MarkdownDescription: `Retrieve information about a Slack conversation. Either 'channel_id' or 'name' must be specified, but not both.
+
+Looking up a conversation by name lists all public and private channels, which is considerably slower than a lookup by ID in large workspaces.
 
 This datasource requires the following scopes:
 
@@ -56,16 +77,56 @@ This datasource requires the following scopes:
 		Attributes: map[string]schema.Attribute{
 			"channel_id": schema.StringAttribute{
 				MarkdownDescription: "The Slack channel ID to look up.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the channel to look up, without the leading `#`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("channel_id")),
+				},
+			},
+			"include_num_members": schema.BoolAttribute{
+				MarkdownDescription: "If true, `num_members` is populated. Counting members makes the lookup slower for large channels.",
+				Optional:            true,
+			},
+			"include_locale": schema.BoolAttribute{
+				MarkdownDescription: "If true, `locale` is populated.",
+				Optional:            true,
+			},
+			"name_normalized": schema.StringAttribute{
+				MarkdownDescription: "The normalized channel name.",
+				Computed:            true,
 			},
 			"topic": schema.StringAttribute{
 				MarkdownDescription: "The channel topic.",
 				Computed:            true,
 			},
+			"topic_creator": schema.StringAttribute{
+				MarkdownDescription: "User ID of the member who last set the topic.",
+				Computed:            true,
+			},
+			"topic_last_set": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the topic was last set.",
+				Computed:            true,
+			},
 			"purpose": schema.StringAttribute{
 				MarkdownDescription: "The channel purpose.",
 				Computed:            true,
 			},
+			"purpose_creator": schema.StringAttribute{
+				MarkdownDescription: "User ID of the member who last set the purpose.",
+				Computed:            true,
+			},
+			"purpose_last_set": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the purpose was last set.",
+				Computed:            true,
+			},
 			"created": schema.Int64Attribute{
 				MarkdownDescription: "UNIX timestamp when the channel was created.",
 				Computed:            true,
@@ -94,6 +155,35 @@ This datasource requires the following scopes:
 				MarkdownDescription: "True if this is the #general channel.",
 				Computed:            true,
 			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "True if the channel is private.",
+				Computed:            true,
+			},
+			"is_channel": schema.BoolAttribute{
+				MarkdownDescription: "True if the conversation is a channel, public or private (as opposed to a direct message).",
+				Computed:            true,
+			},
+			"is_im": schema.BoolAttribute{
+				MarkdownDescription: "True if the conversation is a direct message.",
+				Computed:            true,
+			},
+			"is_mpim": schema.BoolAttribute{
+				MarkdownDescription: "True if the conversation is a multi-person direct message.",
+				Computed:            true,
+			},
+			"num_members": schema.Int64Attribute{
+				MarkdownDescription: "Number of members of the channel. Only populated when `include_num_members` is true.",
+				Computed:            true,
+			},
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "Locale of the channel. Only populated when `include_locale` is true.",
+				Computed:            true,
+			},
+			"connected_team_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the workspaces and Slack Connect organizations the channel is connected to.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -104,15 +194,16 @@ func (d *ConversationDataSource) Configure(ctx context.Context, req datasource.C
 	}
 
 	providerData, ok := req.ProviderData.(*SlackProviderData)
-	if !ok || providerData.Client == nil {
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
 		resp.Diagnostics.AddError(
 			"Invalid Provider Data",
-			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
 		)
 		return
 	}
 
 	d.client = providerData.Client
+	d.queries = providerData.Queries
 }
 
 func (d *ConversationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -122,10 +213,24 @@ func (d *ConversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	if data.ChannelID.IsNull() {
+		match, err := d.queries.FindConversationByName(ctx, data.Name.ValueString(), slack.GetConversationsParameters{
+			Types: []string{"public_channel", "private_channel"},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Could not find conversation %s: %s", data.Name.ValueString(), err),
+			)
+			return
+		}
+		data.ChannelID = types.StringValue(match.ID)
+	}
+
 	channel, err := d.client.GetConversationInfo(ctx, &slack.GetConversationInfoInput{
 		ChannelID:         data.ChannelID.ValueString(),
-		IncludeLocale:     false,
-		IncludeNumMembers: false,
+		IncludeLocale:     data.IncludeLocale.ValueBool(),
+		IncludeNumMembers: data.IncludeNumMembers.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -136,8 +241,14 @@ func (d *ConversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	data.ChannelID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.NameNormalized = types.StringValue(channel.NameNormalized)
 	data.Topic = types.StringValue(channel.Topic.Value)
+	data.TopicCreator = types.StringValue(channel.Topic.Creator)
+	data.TopicLastSet = types.Int64Value(int64(channel.Topic.LastSet))
 	data.Purpose = types.StringValue(channel.Purpose.Value)
+	data.PurposeCreator = types.StringValue(channel.Purpose.Creator)
+	data.PurposeLastSet = types.Int64Value(int64(channel.Purpose.LastSet))
 	data.Created = types.Int64Value(int64(channel.Created))
 	data.Creator = types.StringValue(channel.Creator)
 	data.IsArchived = types.BoolValue(channel.IsArchived)
@@ -145,6 +256,20 @@ func (d *ConversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.IsExtShared = types.BoolValue(channel.IsExtShared)
 	data.IsOrgShared = types.BoolValue(channel.IsOrgShared)
 	data.IsGeneral = types.BoolValue(channel.IsGeneral)
+	data.IsPrivate = types.BoolValue(channel.IsPrivate)
+	data.IsChannel = types.BoolValue(channel.IsChannel)
+	data.IsIM = types.BoolValue(channel.IsIM)
+	data.IsMpIM = types.BoolValue(channel.IsMpIM)
+	data.ConnectedTeamIDs = stringSliceToList(channel.ConnectedTeamIDs)
+
+	data.NumMembers = types.Int64Null()
+	if data.IncludeNumMembers.ValueBool() {
+		data.NumMembers = types.Int64Value(int64(channel.NumMembers))
+	}
+	data.Locale = types.StringNull()
+	if data.IncludeLocale.ValueBool() {
+		data.Locale = types.StringValue(channel.Locale)
+	}
 
 	tflog.Trace(ctx, "Fetched Slack channel data", map[string]any{"channel_id": channel.ID})
 
@@ -19,9 +19,13 @@ import (
 
 func Test_DataSource_Conversation(t *testing.T) {
 	// arrange
-	cb := tb.NewChannelBuilder().WithID("<GIVEN_ID>").WithTopic("<TOPIC>").WithPurpose("<PURPOSE>")
+	cb := tb.NewChannelBuilder().WithID("<GIVEN_ID>").WithName("<NAME>").WithTopic("<TOPIC>").WithPurpose("<PURPOSE>")
 	cb.WithCreated(1234567890).WithCreator("<CREATOR>").WithIsArchived(tb.RandBool()).WithIsShared(tb.RandBool())
 	cb.WithIsExtShared(tb.RandBool()).WithIsOrgShared(tb.RandBool()).WithIsGeneral(tb.RandBool())
+	cb.WithNameNormalized("<NAME_NORMALIZED>").WithTopicCreator("<TOPIC_CREATOR>").WithTopicLastSet(1234567891)
+	cb.WithPurposeCreator("<PURPOSE_CREATOR>").WithPurposeLastSet(1234567892).WithIsPrivate(tb.RandBool())
+	cb.WithIsChannel(tb.RandBool()).WithIsIM(tb.RandBool()).WithIsMpIM(tb.RandBool()).WithConnectedTeamIDs("<TEAM_A>", "<TEAM_B>")
+	cb.WithNumMembers(42).WithLocale("<LOCALE>")
 
 	c := cb.Build()
 
@@ -59,6 +63,7 @@ func Test_DataSource_Conversation(t *testing.T) {
 			tr.TestCheckResourceAttrSet("data.slack_conversation.channel", "is_general"),
 
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "channel_id", tb.ExpectString("<GIVEN_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "name", tb.ExpectString("<NAME>")),
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "topic", tb.ExpectString("<TOPIC>")),
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "purpose", tb.ExpectString("<PURPOSE>")),
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "created", tb.ExpectString("1234567890")),
@@ -68,10 +73,134 @@ func Test_DataSource_Conversation(t *testing.T) {
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_ext_shared", tb.ExpectBool(c.IsExtShared)),
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_org_shared", tb.ExpectBool(c.IsOrgShared)),
 			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_general", tb.ExpectBool(c.IsGeneral)),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "name_normalized", tb.ExpectString("<NAME_NORMALIZED>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "topic_creator", tb.ExpectString("<TOPIC_CREATOR>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "topic_last_set", tb.ExpectString("1234567891")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "purpose_creator", tb.ExpectString("<PURPOSE_CREATOR>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "purpose_last_set", tb.ExpectString("1234567892")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_private", tb.ExpectBool(c.IsPrivate)),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_channel", tb.ExpectBool(c.IsChannel)),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_im", tb.ExpectBool(c.IsIM)),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "is_mpim", tb.ExpectBool(c.IsMpIM)),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "connected_team_ids.#", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "connected_team_ids.0", tb.ExpectString("<TEAM_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "connected_team_ids.1", tb.ExpectString("<TEAM_B>")),
+			tr.TestCheckNoResourceAttr("data.slack_conversation.channel", "num_members"),
+			tr.TestCheckNoResourceAttr("data.slack_conversation.channel", "locale"),
 		),
 	})
 }
 
+func Test_DataSource_Conversation_WithNumMembersAndLocale(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			c := tb.NewChannelBuilder().WithID("<GIVEN_ID>").WithNumMembers(42).WithLocale("<LOCALE>").Build()
+
+			expected_conversation_info_input := &slack.GetConversationInfoInput{
+				ChannelID:         "<GIVEN_ID>",
+				IncludeLocale:     true,
+				IncludeNumMembers: true,
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetConversationInfo(gomock.Any(), expected_conversation_info_input).Return(c, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversation" "channel" {
+				channel_id          = "<GIVEN_ID>"
+				include_num_members = true
+				include_locale      = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "num_members", tb.ExpectString("42")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "locale", tb.ExpectString("<LOCALE>")),
+		),
+	})
+}
+
+func Test_DataSource_Conversation_ByName(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			c := tb.NewChannelBuilder().WithID("<FOUND_ID>").WithName("<GIVEN_NAME>").Build()
+
+			expected_conversations_params := slack.GetConversationsParameters{
+				Types: []string{"public_channel", "private_channel"},
+			}
+			expected_conversation_info_input := &slack.GetConversationInfoInput{
+				ChannelID:         "<FOUND_ID>",
+				IncludeLocale:     false,
+				IncludeNumMembers: false,
+			}
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindConversationByName(gomock.Any(), "<GIVEN_NAME>", expected_conversations_params).Return(*c, nil).AnyTimes()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetConversationInfo(gomock.Any(), expected_conversation_info_input).Return(c, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversation" "channel" {
+				name = "<GIVEN_NAME>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "channel_id", tb.ExpectString("<FOUND_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversation.channel", "name", tb.ExpectString("<GIVEN_NAME>")),
+		),
+	})
+}
+
+func Test_DataSource_Conversation_Error_When_NameAmbiguous(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindConversationByName(gomock.Any(), "<GIVEN_NAME>", gomock.Any()).Return(slack.Channel{}, errors.New("<AMBIGUOUS_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversation" "channel" {
+				name = "<GIVEN_NAME>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<AMBIGUOUS_ERROR>"),
+	})
+}
+
+func Test_DataSource_Conversation_Error_When_IDAndNameGiven(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversation" "channel" {
+				channel_id = "<GIVEN_ID>"
+				name       = "<GIVEN_NAME>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	})
+}
+
 func Test_DataSource_Conversation_Error_When_RetrievalFailed(t *testing.T) {
 	testConfig(t, tr.TestStep{
 		// arrange
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ datasource.DataSource                   = &ConversationsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConversationsDataSource{}
+)
+
+func NewConversationsDataSource() datasource.DataSource {
+	return &ConversationsDataSource{}
+}
+
+type ConversationsDataSource struct {
+	queries slackExt.Queries
+}
+
+type ConversationsDataSourceModel struct {
+	Types              types.Set                                      `tfsdk:"types"`
+	ExcludeArchived    types.Bool                                     `tfsdk:"exclude_archived"`
+	TeamID             types.String                                   `tfsdk:"team_id"`
+	NamePrefix         types.String                                   `tfsdk:"name_prefix"`
+	NameRegex          types.String                                   `tfsdk:"name_regex"`
+	TotalConversations types.Int64                                    `tfsdk:"total_conversations"`
+	Conversations      []ConversationsDataSourceModelConversationItem `tfsdk:"conversations"`
+}
+
+type ConversationsDataSourceModelConversationItem struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	IsPrivate  types.Bool   `tfsdk:"is_private"`
+	IsArchived types.Bool   `tfsdk:"is_archived"`
+	NumMembers types.Int64  `tfsdk:"num_members"`
+	Topic      types.String `tfsdk:"topic"`
+	Purpose    types.String `tfsdk:"purpose"`
+}
+
+func (d *ConversationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversations"
+}
+
+func (d *ConversationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve a list of Slack conversations, optionally filtered by name.
+
+This datasource requires the following scopes:
+
+- channels:read (public channels)
+- groups:read (private channels)
+- im:read (optional)
+- mpim:read (optional)`,
+		Attributes: map[string]schema.Attribute{
+			"types": schema.SetAttribute{
+				MarkdownDescription: "Conversation types to include: `public_channel`, `private_channel`, `mpim` and/or `im`. Defaults to `public_channel`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("public_channel", "private_channel", "mpim", "im")),
+				},
+			},
+			"exclude_archived": schema.BoolAttribute{
+				MarkdownDescription: "If true, archived conversations are left out.",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to list conversations of. Required for org-level tokens.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include conversations whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include conversations whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"total_conversations": schema.Int64Attribute{
+				Description: "Number of conversations returned.",
+				Computed:    true,
+			},
+			"conversations": schema.ListNestedAttribute{
+				Description: "List of conversations matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Conversation's Slack ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Conversation's name.",
+							Computed:    true,
+						},
+						"is_private": schema.BoolAttribute{
+							Description: "True if the conversation is private.",
+							Computed:    true,
+						},
+						"is_archived": schema.BoolAttribute{
+							Description: "True if the conversation is archived.",
+							Computed:    true,
+						},
+						"num_members": schema.Int64Attribute{
+							Description: "Number of members of the conversation.",
+							Computed:    true,
+						},
+						"topic": schema.StringAttribute{
+							Description: "Conversation's topic.",
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Conversation's purpose.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConversationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ConversationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.NameRegex.IsNull() || config.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *ConversationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *ConversationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConversationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ValidateConfig skips values that are unknown at plan time, so compile errors can still occur here.
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	params := slack.GetConversationsParameters{
+		ExcludeArchived: data.ExcludeArchived.ValueBool(),
+		TeamID:          data.TeamID.ValueString(),
+	}
+	if !data.Types.IsNull() {
+		params.Types = setToStringSlice(data.Types)
+	}
+
+	channels, err := d.queries.GetAllConversations(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch Slack conversations: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack conversations", map[string]any{"total_conversations": len(channels)})
+
+	resultingList := []ConversationsDataSourceModelConversationItem{}
+	for _, channel := range channels {
+		if !strings.HasPrefix(channel.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(channel.Name) {
+			continue
+		}
+
+		resultingList = append(resultingList, ConversationsDataSourceModelConversationItem{
+			ID:         types.StringValue(channel.ID),
+			Name:       types.StringValue(channel.Name),
+			IsPrivate:  types.BoolValue(channel.IsPrivate),
+			IsArchived: types.BoolValue(channel.IsArchived),
+			NumMembers: types.Int64Value(int64(channel.NumMembers)),
+			Topic:      types.StringValue(channel.Topic.Value),
+			Purpose:    types.StringValue(channel.Purpose.Value),
+		})
+	}
+
+	data.Conversations = resultingList
+	data.TotalConversations = types.Int64Value(int64(len(resultingList)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
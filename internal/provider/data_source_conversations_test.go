@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_Conversations(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			cA := tb.NewChannelBuilder().WithID("<ID_A>").WithName("team-a").WithIsPrivate(true).WithNumMembers(3).WithTopic("<TOPIC_A>").WithPurpose("<PURPOSE_A>").Build()
+			cB := tb.NewChannelBuilder().WithID("<ID_B>").WithName("team-b-old").WithIsArchived(true).Build()
+			cC := tb.NewChannelBuilder().WithID("<ID_C>").WithName("general").Build()
+
+			expected_conversations_params := slack.GetConversationsParameters{
+				Types:           []string{"private_channel"},
+				ExcludeArchived: false,
+				TeamID:          "<TEAM_ID>",
+			}
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllConversations(gomock.Any(), expected_conversations_params).Return([]slack.Channel{*cA, *cB, *cC}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversations" "teams" {
+				types       = ["private_channel"]
+				team_id     = "<TEAM_ID>"
+				name_prefix = "team-"
+				name_regex  = "^team-[a-z]$"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "total_conversations", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.name", tb.ExpectString("team-a")),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.is_private", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.is_archived", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.num_members", tb.ExpectString("3")),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.topic", tb.ExpectString("<TOPIC_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_conversations.teams", "conversations.0.purpose", tb.ExpectString("<PURPOSE_A>")),
+		),
+	})
+}
+
+func Test_DataSource_Conversations_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllConversations(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversations" "all" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Conversations_Error_When_RegexInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_conversations" "all" {
+				name_regex = "team-("
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+	})
+}
+
+func Test_DataSource_Conversations_Error_When_UnknownRegexInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "terraform_data" "pattern" {
+				input = "team-("
+			}
+
+			data "slack_conversations" "all" {
+				name_regex = terraform_data.pattern.output
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+	})
+}
+
+func Test_DataSource_Conversations_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := ConversationsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const emojiAliasPrefix = "alias:"
+
+var _ datasource.DataSource = &EmojiDataSource{}
+
+func NewEmojiDataSource() datasource.DataSource {
+	return &EmojiDataSource{}
+}
+
+type EmojiDataSource struct {
+	client slackExt.Client
+}
+
+type EmojiDataSourceModel struct {
+	IncludeCustom   types.Bool   `tfsdk:"include_custom"`
+	IncludeStandard types.Bool   `tfsdk:"include_standard"`
+	NamePrefix      types.String `tfsdk:"name_prefix"`
+	Emoji           types.Map    `tfsdk:"emoji"`
+	Aliases         types.Map    `tfsdk:"aliases"`
+}
+
+func (d *EmojiDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+func (d *EmojiDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the emoji available in the workspace.
+
+This datasource requires the following scopes:
+
+- emoji:read`,
+		Attributes: map[string]schema.Attribute{
+			"include_custom": schema.BoolAttribute{
+				MarkdownDescription: "If false, custom emoji are left out. Defaults to true.",
+				Optional:            true,
+			},
+			"include_standard": schema.BoolAttribute{
+				MarkdownDescription: "If true, the standard Unicode emoji are included. Defaults to false.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include emoji whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"emoji": schema.MapAttribute{
+				MarkdownDescription: "Image URLs, keyed by emoji name. Aliases are resolved to the URL of the emoji they refer to. Standard emoji have no image and map to an empty string.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"aliases": schema.MapAttribute{
+				MarkdownDescription: "Names of the emoji the included aliases refer to, keyed by alias.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EmojiDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *EmojiDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmojiDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeCustom := data.IncludeCustom.IsNull() || data.IncludeCustom.ValueBool()
+	includeStandard := data.IncludeStandard.ValueBool()
+
+	list, err := d.client.GetEmoji(ctx, includeStandard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch emoji: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack emoji", map[string]any{"total_emoji": len(list.Emoji)})
+
+	prefix := data.NamePrefix.ValueString()
+	emoji := map[string]attr.Value{}
+	aliases := map[string]attr.Value{}
+
+	if includeStandard {
+		for _, category := range list.Categories {
+			for _, name := range category.EmojiNames {
+				if strings.HasPrefix(name, prefix) {
+					emoji[name] = types.StringValue("")
+				}
+			}
+		}
+	}
+
+	for name, value := range list.Emoji {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		target, isAlias := strings.CutPrefix(value, emojiAliasPrefix)
+		url := resolveEmojiURL(list.Emoji, name)
+
+		// An alias of a standard emoji has no image of its own and behaves like the standard emoji.
+		if (url != "" && !includeCustom) || (url == "" && !includeStandard) {
+			continue
+		}
+
+		emoji[name] = types.StringValue(url)
+		if isAlias {
+			aliases[name] = types.StringValue(target)
+		}
+	}
+
+	data.Emoji = types.MapValueMust(types.StringType, emoji)
+	data.Aliases = types.MapValueMust(types.StringType, aliases)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveEmojiURL follows aliases until it reaches an image URL. It returns an empty string for
+// (aliases of) standard emoji, which are not part of emoji.list.
+func resolveEmojiURL(emoji map[string]string, name string) string {
+	for range len(emoji) + 1 {
+		value, ok := emoji[name]
+		if !ok {
+			return ""
+		}
+		target, isAlias := strings.CutPrefix(value, emojiAliasPrefix)
+		if !isAlias {
+			return value
+		}
+		name = target
+	}
+	return ""
+}
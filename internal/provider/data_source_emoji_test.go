@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.uber.org/mock/gomock"
+)
+
+func testEmojiList() *slackExt.EmojiList {
+	return &slackExt.EmojiList{
+		Emoji: map[string]string{
+			"party":        "<PARTY_URL>",
+			"party-alias":  "alias:party",
+			"thumbs-alias": "alias:+1",
+		},
+		Categories: []slackExt.EmojiCategory{
+			{Name: "people", EmojiNames: []string{"+1", "smile"}},
+			{Name: "activity", EmojiNames: []string{"partying_face"}},
+		},
+	}
+}
+
+func Test_DataSource_Emoji(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetEmoji(gomock.Any(), false).Return(testEmojiList(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_emoji" "custom" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_emoji.custom", "emoji.%", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.custom", "emoji.party", tb.ExpectString("<PARTY_URL>")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.custom", "emoji.party-alias", tb.ExpectString("<PARTY_URL>")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.custom", "aliases.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.custom", "aliases.party-alias", tb.ExpectString("party")),
+		),
+	})
+}
+
+func Test_DataSource_Emoji_StandardWithPrefix(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetEmoji(gomock.Any(), true).Return(testEmojiList(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_emoji" "standard" {
+				include_custom   = false
+				include_standard = true
+				name_prefix      = "part"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_emoji.standard", "emoji.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.standard", "emoji.partying_face", tb.ExpectString("")),
+			tr.TestCheckResourceAttrWith("data.slack_emoji.standard", "aliases.%", tb.ExpectString("0")),
+		),
+	})
+}
+
+func Test_DataSource_Emoji_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetEmoji(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_emoji" "custom" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Emoji_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := EmojiDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &MessagePermalinkDataSource{}
+
+var messageTsRegex = regexp.MustCompile(`^\d+\.\d+$`)
+
+func NewMessagePermalinkDataSource() datasource.DataSource {
+	return &MessagePermalinkDataSource{}
+}
+
+type MessagePermalinkDataSource struct {
+	client slackExt.Client
+}
+
+type MessagePermalinkDataSourceModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	MessageTs types.String `tfsdk:"message_ts"`
+	Permalink types.String `tfsdk:"permalink"`
+}
+
+func (d *MessagePermalinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message_permalink"
+}
+
+func (d *MessagePermalinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the permalink of a Slack message. Fails if the message does not exist or is not visible to the token.
+
+This datasource does not require any scopes.`,
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the conversation the message was posted in.",
+				Required:            true,
+			},
+			"message_ts": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the message, e.g. `1700000000.123456`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(messageTsRegex, "must be a Slack message timestamp, e.g. `1700000000.123456`"),
+				},
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: "Permalink of the message.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MessagePermalinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *MessagePermalinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MessagePermalinkDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permalink, err := d.client.GetPermalink(ctx, &slack.PermalinkParameters{
+		Channel: data.ChannelID.ValueString(),
+		Ts:      data.MessageTs.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch permalink of message %s in %s: %s", data.MessageTs.ValueString(), data.ChannelID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Permalink = types.StringValue(permalink)
+
+	tflog.Trace(ctx, "Fetched Slack message permalink", map[string]any{"channel_id": data.ChannelID.ValueString(), "message_ts": data.MessageTs.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
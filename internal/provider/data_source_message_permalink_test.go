@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_MessagePermalink(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			expected_permalink_params := &slack.PermalinkParameters{Channel: "<CHANNEL_ID>", Ts: "1700000000.123456"}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetPermalink(gomock.Any(), expected_permalink_params).Return("<PERMALINK>", nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_message_permalink" "runbook" {
+				channel_id = "<CHANNEL_ID>"
+				message_ts = "1700000000.123456"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_message_permalink.runbook", "permalink", tb.ExpectString("<PERMALINK>")),
+		),
+	})
+}
+
+func Test_DataSource_MessagePermalink_Error_When_TsInvalid(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_message_permalink" "runbook" {
+				channel_id = "<CHANNEL_ID>"
+				message_ts = "p1700000000123456"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("must be a Slack message timestamp"),
+	})
+}
+
+func Test_DataSource_MessagePermalink_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetPermalink(gomock.Any(), gomock.Any()).Return("", errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_message_permalink" "runbook" {
+				channel_id = "<CHANNEL_ID>"
+				message_ts = "1700000000.123456"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_MessagePermalink_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := MessagePermalinkDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &PinsDataSource{}
+
+func NewPinsDataSource() datasource.DataSource {
+	return &PinsDataSource{}
+}
+
+type PinsDataSource struct {
+	client slackExt.Client
+}
+
+type PinsDataSourceModel struct {
+	ChannelID types.String                 `tfsdk:"channel_id"`
+	TotalPins types.Int64                  `tfsdk:"total_pins"`
+	Pins      []PinsDataSourceModelPinItem `tfsdk:"pins"`
+}
+
+type PinsDataSourceModelPinItem struct {
+	Type      types.String `tfsdk:"type"`
+	Ts        types.String `tfsdk:"ts"`
+	FileID    types.String `tfsdk:"file_id"`
+	Text      types.String `tfsdk:"text"`
+	Created   types.Int64  `tfsdk:"created"`
+	CreatedBy types.String `tfsdk:"created_by"`
+	Permalink types.String `tfsdk:"permalink"`
+}
+
+func (d *PinsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pins"
+}
+
+func (d *PinsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the items pinned to a Slack conversation.
+
+This datasource requires the following scopes:
+
+- pins:read`,
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the conversation to list the pinned items of.",
+				Required:            true,
+			},
+			"total_pins": schema.Int64Attribute{
+				Description: "Number of pinned items.",
+				Computed:    true,
+			},
+			"pins": schema.ListNestedAttribute{
+				Description: "List of pinned items.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the pinned item, i.e. message or file.",
+							Computed:    true,
+						},
+						"ts": schema.StringAttribute{
+							Description: "Timestamp of the pinned message. Empty for files.",
+							Computed:    true,
+						},
+						"file_id": schema.StringAttribute{
+							Description: "ID of the pinned file. Empty for messages.",
+							Computed:    true,
+						},
+						"text": schema.StringAttribute{
+							Description: "Text of the pinned message. Empty for files.",
+							Computed:    true,
+						},
+						"created": schema.Int64Attribute{
+							Description: "UNIX timestamp when the item was pinned.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "ID of the user who pinned the item.",
+							Computed:    true,
+						},
+						"permalink": schema.StringAttribute{
+							Description: "Permalink of the pinned message or file.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PinsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *PinsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PinsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.client.ListPins(ctx, data.ChannelID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch pins of %s: %s", data.ChannelID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack pins", map[string]any{"channel_id": data.ChannelID.ValueString(), "total_pins": len(items)})
+
+	resultingList := []PinsDataSourceModelPinItem{}
+	for _, item := range items {
+		pin := PinsDataSourceModelPinItem{
+			Type:      types.StringValue(item.Type),
+			Ts:        types.StringValue(""),
+			FileID:    types.StringValue(""),
+			Text:      types.StringValue(""),
+			Created:   types.Int64Value(item.Created),
+			CreatedBy: types.StringValue(item.CreatedBy),
+			Permalink: types.StringValue(""),
+		}
+		if item.Message != nil {
+			pin.Ts = types.StringValue(item.Message.Timestamp)
+			pin.Text = types.StringValue(item.Message.Text)
+			pin.Permalink = types.StringValue(item.Message.Permalink)
+		}
+		if item.File != nil {
+			pin.FileID = types.StringValue(item.File.ID)
+			pin.Permalink = types.StringValue(item.File.Permalink)
+		}
+		resultingList = append(resultingList, pin)
+	}
+
+	data.Pins = resultingList
+	data.TotalPins = types.Int64Value(int64(len(resultingList)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
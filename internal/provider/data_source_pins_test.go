@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_Pins(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			message := &slack.Message{}
+			message.Timestamp = "1700000000.123456"
+			message.Text = "<TEXT>"
+			message.Permalink = "<MESSAGE_PERMALINK>"
+
+			items := []slackExt.PinnedItem{
+				{Type: "message", Channel: "<CHANNEL_ID>", Created: 1700000100, CreatedBy: "<USER_A>", Message: message},
+				{Type: "file", Channel: "<CHANNEL_ID>", Created: 1700000200, CreatedBy: "<USER_B>", File: &slack.File{ID: "<FILE_ID>", Permalink: "<FILE_PERMALINK>"}},
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().ListPins(gomock.Any(), "<CHANNEL_ID>").Return(items, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_pins" "pins" {
+				channel_id = "<CHANNEL_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "total_pins", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.type", tb.ExpectString("message")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.ts", tb.ExpectString("1700000000.123456")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.file_id", tb.ExpectString("")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.text", tb.ExpectString("<TEXT>")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.created", tb.ExpectString("1700000100")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.created_by", tb.ExpectString("<USER_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.0.permalink", tb.ExpectString("<MESSAGE_PERMALINK>")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.1.type", tb.ExpectString("file")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.1.ts", tb.ExpectString("")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.1.file_id", tb.ExpectString("<FILE_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.1.created_by", tb.ExpectString("<USER_B>")),
+			tr.TestCheckResourceAttrWith("data.slack_pins.pins", "pins.1.permalink", tb.ExpectString("<FILE_PERMALINK>")),
+		),
+	})
+}
+
+func Test_DataSource_Pins_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().ListPins(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_pins" "pins" {
+				channel_id = "<CHANNEL_ID>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Pins_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := PinsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
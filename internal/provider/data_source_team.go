@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TeamDataSource{}
+
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+type TeamDataSource struct {
+	client slackExt.Client
+}
+
+type TeamDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Domain         types.String `tfsdk:"domain"`
+	EmailDomain    types.String `tfsdk:"email_domain"`
+	Icon34         types.String `tfsdk:"icon_34"`
+	Icon44         types.String `tfsdk:"icon_44"`
+	Icon68         types.String `tfsdk:"icon_68"`
+	Icon88         types.String `tfsdk:"icon_88"`
+	Icon102        types.String `tfsdk:"icon_102"`
+	Icon132        types.String `tfsdk:"icon_132"`
+	Icon230        types.String `tfsdk:"icon_230"`
+	IconIsDefault  types.Bool   `tfsdk:"icon_is_default"`
+	EnterpriseID   types.String `tfsdk:"enterprise_id"`
+	EnterpriseName types.String `tfsdk:"enterprise_name"`
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve information about a Slack workspace. Without 'id', the workspace of the token is returned.
+
+This datasource requires the following scopes:
+
+- team:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to look up.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workspace.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the workspace, i.e. the part before `.slack.com`.",
+				Computed:            true,
+			},
+			"email_domain": schema.StringAttribute{
+				MarkdownDescription: "Email domains allowed to sign up to the workspace, separated by commas.",
+				Computed:            true,
+			},
+			"icon_34": schema.StringAttribute{
+				MarkdownDescription: "URL of the 34x34 workspace icon.",
+				Computed:            true,
+			},
+			"icon_44": schema.StringAttribute{
+				MarkdownDescription: "URL of the 44x44 workspace icon.",
+				Computed:            true,
+			},
+			"icon_68": schema.StringAttribute{
+				MarkdownDescription: "URL of the 68x68 workspace icon.",
+				Computed:            true,
+			},
+			"icon_88": schema.StringAttribute{
+				MarkdownDescription: "URL of the 88x88 workspace icon.",
+				Computed:            true,
+			},
+			"icon_102": schema.StringAttribute{
+				MarkdownDescription: "URL of the 102x102 workspace icon.",
+				Computed:            true,
+			},
+			"icon_132": schema.StringAttribute{
+				MarkdownDescription: "URL of the 132x132 workspace icon.",
+				Computed:            true,
+			},
+			"icon_230": schema.StringAttribute{
+				MarkdownDescription: "URL of the 230x230 workspace icon.",
+				Computed:            true,
+			},
+			"icon_is_default": schema.BoolAttribute{
+				MarkdownDescription: "True if the workspace uses the default icon.",
+				Computed:            true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Enterprise Grid organization the workspace belongs to. Empty outside of Enterprise Grid.",
+				Computed:            true,
+			},
+			"enterprise_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Enterprise Grid organization the workspace belongs to. Empty outside of Enterprise Grid.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := d.client.GetTeamInfo(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch team info: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(team.ID)
+	data.Name = types.StringValue(team.Name)
+	data.Domain = types.StringValue(team.Domain)
+	data.EmailDomain = types.StringValue(team.EmailDomain)
+	data.Icon34 = types.StringValue(team.Icon.Image34)
+	data.Icon44 = types.StringValue(team.Icon.Image44)
+	data.Icon68 = types.StringValue(team.Icon.Image68)
+	data.Icon88 = types.StringValue(team.Icon.Image88)
+	data.Icon102 = types.StringValue(team.Icon.Image102)
+	data.Icon132 = types.StringValue(team.Icon.Image132)
+	data.Icon230 = types.StringValue(team.Icon.Image230)
+	data.IconIsDefault = types.BoolValue(team.Icon.ImageDefault)
+	data.EnterpriseID = types.StringValue(team.EnterpriseID)
+	data.EnterpriseName = types.StringValue(team.EnterpriseName)
+
+	tflog.Trace(ctx, "Fetched Slack team data", map[string]any{"id": team.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
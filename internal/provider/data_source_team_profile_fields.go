@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TeamProfileFieldsDataSource{}
+
+func NewTeamProfileFieldsDataSource() datasource.DataSource {
+	return &TeamProfileFieldsDataSource{}
+}
+
+type TeamProfileFieldsDataSource struct {
+	client slackExt.Client
+}
+
+type TeamProfileFieldsDataSourceModel struct {
+	TeamID        types.String                                `tfsdk:"team_id"`
+	IncludeHidden types.Bool                                  `tfsdk:"include_hidden"`
+	Fields        []TeamProfileFieldsDataSourceModelFieldItem `tfsdk:"fields"`
+	FieldIDs      types.Map                                   `tfsdk:"field_ids"`
+}
+
+type TeamProfileFieldsDataSourceModelFieldItem struct {
+	ID           types.String `tfsdk:"id"`
+	Label        types.String `tfsdk:"label"`
+	FieldName    types.String `tfsdk:"field_name"`
+	Type         types.String `tfsdk:"type"`
+	Hint         types.String `tfsdk:"hint"`
+	Options      types.List   `tfsdk:"options"`
+	Ordering     types.Int64  `tfsdk:"ordering"`
+	IsHidden     types.Bool   `tfsdk:"is_hidden"`
+	SectionID    types.String `tfsdk:"section_id"`
+	SectionLabel types.String `tfsdk:"section_label"`
+}
+
+func (d *TeamProfileFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_profile_fields"
+}
+
+func (d *TeamProfileFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the profile fields defined for the workspace, including custom fields.
+
+This datasource requires the following scopes:
+
+- users.profile:read`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to retrieve the profile fields of. Required for org-level tokens.",
+				Optional:            true,
+			},
+			"include_hidden": schema.BoolAttribute{
+				MarkdownDescription: "If true, hidden profile fields are included.",
+				Optional:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "Profile fields, in the order they are shown in profiles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Field's Slack ID.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Field's label.",
+							Computed:    true,
+						},
+						"field_name": schema.StringAttribute{
+							Description: "Name of the built-in profile attribute the field maps to. Empty for custom fields.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Field's type, e.g. text, date, link, options_list or user.",
+							Computed:    true,
+						},
+						"hint": schema.StringAttribute{
+							Description: "Field's hint.",
+							Computed:    true,
+						},
+						"options": schema.ListAttribute{
+							Description: "Values that can be selected for an options_list field.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ordering": schema.Int64Attribute{
+							Description: "Position of the field in profiles.",
+							Computed:    true,
+						},
+						"is_hidden": schema.BoolAttribute{
+							Description: "True if the field is hidden.",
+							Computed:    true,
+						},
+						"section_id": schema.StringAttribute{
+							Description: "ID of the profile section the field belongs to.",
+							Computed:    true,
+						},
+						"section_label": schema.StringAttribute{
+							Description: "Label of the profile section the field belongs to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"field_ids": schema.MapAttribute{
+				MarkdownDescription: "Field IDs, keyed by label. When labels collide, the first field in profile order wins.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamProfileFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TeamProfileFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamProfileFieldsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := d.client.GetTeamProfile(ctx, data.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch team profile: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack team profile", map[string]any{"fields": len(profile.Fields), "sections": len(profile.Sections)})
+
+	sectionLabels := make(map[string]string, len(profile.Sections))
+	for _, section := range profile.Sections {
+		sectionLabels[section.ID] = section.Label
+	}
+
+	fields := profile.Fields
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Ordering < fields[j].Ordering })
+
+	resultingList := []TeamProfileFieldsDataSourceModelFieldItem{}
+	fieldIDs := map[string]attr.Value{}
+	for _, field := range fields {
+		if field.IsHidden && !data.IncludeHidden.ValueBool() {
+			continue
+		}
+
+		resultingList = append(resultingList, TeamProfileFieldsDataSourceModelFieldItem{
+			ID:           types.StringValue(field.ID),
+			Label:        types.StringValue(field.Label),
+			FieldName:    types.StringValue(field.FieldName),
+			Type:         types.StringValue(field.Type),
+			Hint:         types.StringValue(field.Hint),
+			Options:      stringSliceToList(field.PossibleValues),
+			Ordering:     types.Int64Value(int64(field.Ordering)),
+			IsHidden:     types.BoolValue(field.IsHidden),
+			SectionID:    types.StringValue(field.SectionID),
+			SectionLabel: types.StringValue(sectionLabels[field.SectionID]),
+		})
+		if _, ok := fieldIDs[field.Label]; !ok {
+			fieldIDs[field.Label] = types.StringValue(field.ID)
+		}
+	}
+
+	data.Fields = resultingList
+	data.FieldIDs = types.MapValueMust(types.StringType, fieldIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.uber.org/mock/gomock"
+)
+
+func testTeamProfile() *slackExt.TeamProfile {
+	return &slackExt.TeamProfile{
+		Fields: []slackExt.TeamProfileField{
+			{ID: "<DEPARTMENT_ID>", Ordering: 2, Label: "<DEPARTMENT>", Type: "options_list", PossibleValues: []string{"<SALES>", "<IT>"}, SectionID: "<SECTION_ID>"},
+			{ID: "<TITLE_ID>", Ordering: 0, Label: "<TITLE>", FieldName: "title", Type: "text", Hint: "<HINT>", SectionID: "<SECTION_ID>"},
+			{ID: "<HIDDEN_ID>", Ordering: 1, Label: "<HIDDEN>", Type: "text", IsHidden: true},
+		},
+		Sections: []slackExt.TeamProfileSection{
+			{ID: "<SECTION_ID>", Label: "<SECTION>", SectionType: "contact"},
+		},
+	}
+}
+
+func Test_DataSource_TeamProfileFields(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamProfile(gomock.Any(), "").Return(testTeamProfile(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team_profile_fields" "fields" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.#", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.0.id", tb.ExpectString("<TITLE_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.0.field_name", tb.ExpectString("title")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.0.hint", tb.ExpectString("<HINT>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.0.section_label", tb.ExpectString("<SECTION>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.id", tb.ExpectString("<DEPARTMENT_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.type", tb.ExpectString("options_list")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.options.#", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.options.0", tb.ExpectString("<SALES>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "field_ids.%", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "field_ids.<DEPARTMENT>", tb.ExpectString("<DEPARTMENT_ID>")),
+		),
+	})
+}
+
+func Test_DataSource_TeamProfileFields_IncludeHidden(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamProfile(gomock.Any(), "<TEAM_ID>").Return(testTeamProfile(), nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team_profile_fields" "fields" {
+				team_id        = "<TEAM_ID>"
+				include_hidden = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.#", tb.ExpectString("3")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.id", tb.ExpectString("<HIDDEN_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "fields.1.is_hidden", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_team_profile_fields.fields", "field_ids.<HIDDEN>", tb.ExpectString("<HIDDEN_ID>")),
+		),
+	})
+}
+
+func Test_DataSource_TeamProfileFields_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamProfile(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team_profile_fields" "fields" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_TeamProfileFields_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := TeamProfileFieldsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_Team(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			team := &slackExt.TeamInfo{
+				ID:             "<ID>",
+				Name:           "<NAME>",
+				Domain:         "<DOMAIN>",
+				EmailDomain:    "<EMAIL_DOMAIN>",
+				Icon:           slackExt.TeamIcon{Image68: "<ICON_68>", Image230: "<ICON_230>"},
+				EnterpriseID:   "<ENTERPRISE_ID>",
+				EnterpriseName: "<ENTERPRISE_NAME>",
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamInfo(gomock.Any(), "").Return(team, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team" "team" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "id", tb.ExpectString("<ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "name", tb.ExpectString("<NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "domain", tb.ExpectString("<DOMAIN>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "email_domain", tb.ExpectString("<EMAIL_DOMAIN>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "icon_68", tb.ExpectString("<ICON_68>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "icon_230", tb.ExpectString("<ICON_230>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "icon_is_default", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "enterprise_id", tb.ExpectString("<ENTERPRISE_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "enterprise_name", tb.ExpectString("<ENTERPRISE_NAME>")),
+		),
+	})
+}
+
+func Test_DataSource_Team_ByID(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamInfo(gomock.Any(), "<GIVEN_ID>").Return(&slackExt.TeamInfo{ID: "<GIVEN_ID>", Name: "<NAME>"}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team" "team" {
+				id = "<GIVEN_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "id", tb.ExpectString("<GIVEN_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_team.team", "name", tb.ExpectString("<NAME>")),
+		),
+	})
+}
+
+func Test_DataSource_Team_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetTeamInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_team" "team" {}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Team_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := TeamDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/essent/terraform-provider-slack/internal/slackExt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,9 +31,30 @@ type UserDataSource struct {
 }
 
 type UserDataSourceModel struct {
-	Email types.String `tfsdk:"email"`
-	Name  types.String `tfsdk:"name"`
-	ID    types.String `tfsdk:"id"`
+	Email               types.String `tfsdk:"email"`
+	Name                types.String `tfsdk:"name"`
+	ID                  types.String `tfsdk:"id"`
+	IncludeCustomFields types.Bool   `tfsdk:"include_custom_fields"`
+	RealName            types.String `tfsdk:"real_name"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	Title               types.String `tfsdk:"title"`
+	Phone               types.String `tfsdk:"phone"`
+	TZ                  types.String `tfsdk:"tz"`
+	TZOffset            types.Int64  `tfsdk:"tz_offset"`
+	IsAdmin             types.Bool   `tfsdk:"is_admin"`
+	IsOwner             types.Bool   `tfsdk:"is_owner"`
+	IsBot               types.Bool   `tfsdk:"is_bot"`
+	IsRestricted        types.Bool   `tfsdk:"is_restricted"`
+	IsUltraRestricted   types.Bool   `tfsdk:"is_ultra_restricted"`
+	IsAppUser           types.Bool   `tfsdk:"is_app_user"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Image24             types.String `tfsdk:"image_24"`
+	Image32             types.String `tfsdk:"image_32"`
+	Image48             types.String `tfsdk:"image_48"`
+	Image72             types.String `tfsdk:"image_72"`
+	Image192            types.String `tfsdk:"image_192"`
+	Image512            types.String `tfsdk:"image_512"`
+	CustomFields        types.Map    `tfsdk:"custom_fields"`
 }
 
 func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,7 +68,8 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 This datasource requires the following scopes:
 
 - users:read.email
-- users:read`,
+- users:read
+- users.profile:read (optional, for custom profile fields)`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Slack user ID to look up.",
@@ -62,10 +85,95 @@ This datasource requires the following scopes:
 					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("id")),
 				},
 			},
+			"include_custom_fields": schema.BoolAttribute{
+				MarkdownDescription: "If true, `custom_fields` is populated. This costs an additional API call.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "User's name.",
 				Computed:            true,
 			},
+			"real_name": schema.StringAttribute{
+				MarkdownDescription: "User's real name.",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "User's display name.",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "User's title.",
+				Computed:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "User's phone number.",
+				Computed:            true,
+			},
+			"tz": schema.StringAttribute{
+				MarkdownDescription: "User's time zone, e.g. `Europe/Amsterdam`.",
+				Computed:            true,
+			},
+			"tz_offset": schema.Int64Attribute{
+				MarkdownDescription: "Offset of the user's time zone from UTC, in seconds.",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is an admin of the workspace.",
+				Computed:            true,
+			},
+			"is_owner": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is an owner of the workspace.",
+				Computed:            true,
+			},
+			"is_bot": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is a bot.",
+				Computed:            true,
+			},
+			"is_restricted": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is a multi-channel guest.",
+				Computed:            true,
+			},
+			"is_ultra_restricted": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is a single-channel guest.",
+				Computed:            true,
+			},
+			"is_app_user": schema.BoolAttribute{
+				MarkdownDescription: "True if the user is an app user.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user's workspace.",
+				Computed:            true,
+			},
+			"image_24": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 24x24 avatar.",
+				Computed:            true,
+			},
+			"image_32": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 32x32 avatar.",
+				Computed:            true,
+			},
+			"image_48": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 48x48 avatar.",
+				Computed:            true,
+			},
+			"image_72": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 72x72 avatar.",
+				Computed:            true,
+			},
+			"image_192": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 192x192 avatar.",
+				Computed:            true,
+			},
+			"image_512": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 512x512 avatar.",
+				Computed:            true,
+			},
+			"custom_fields": schema.MapAttribute{
+				MarkdownDescription: "Values of the user's custom profile fields, keyed by field ID. Only populated when `include_custom_fields` is true.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -126,6 +234,44 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.Email = types.StringValue(user.Profile.Email)
 	data.Name = types.StringValue(user.Name)
 	data.ID = types.StringValue(user.ID)
+	data.RealName = types.StringValue(user.RealName)
+	data.DisplayName = types.StringValue(user.Profile.DisplayName)
+	data.Title = types.StringValue(user.Profile.Title)
+	data.Phone = types.StringValue(user.Profile.Phone)
+	data.TZ = types.StringValue(user.TZ)
+	data.TZOffset = types.Int64Value(int64(user.TZOffset))
+	data.IsAdmin = types.BoolValue(user.IsAdmin)
+	data.IsOwner = types.BoolValue(user.IsOwner)
+	data.IsBot = types.BoolValue(user.IsBot)
+	data.IsRestricted = types.BoolValue(user.IsRestricted)
+	data.IsUltraRestricted = types.BoolValue(user.IsUltraRestricted)
+	data.IsAppUser = types.BoolValue(user.IsAppUser)
+	data.TeamID = types.StringValue(user.TeamID)
+	data.Image24 = types.StringValue(user.Profile.Image24)
+	data.Image32 = types.StringValue(user.Profile.Image32)
+	data.Image48 = types.StringValue(user.Profile.Image48)
+	data.Image72 = types.StringValue(user.Profile.Image72)
+	data.Image192 = types.StringValue(user.Profile.Image192)
+	data.Image512 = types.StringValue(user.Profile.Image512)
+
+	data.CustomFields = types.MapNull(types.StringType)
+	if data.IncludeCustomFields.ValueBool() {
+		// users.info does not return custom profile fields, only users.profile.get does.
+		profile, err := d.client.GetUserProfile(ctx, &slack.GetUserProfileParameters{UserID: user.ID})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to fetch user profile: %s", err),
+			)
+			return
+		}
+
+		customFields := make(map[string]attr.Value, profile.Fields.Len())
+		for id, field := range profile.FieldsMap() {
+			customFields[id] = types.StringValue(field.Value)
+		}
+		data.CustomFields = types.MapValueMust(types.StringType, customFields)
+	}
 
 	tflog.Trace(ctx, "Fetched Slack user data", map[string]any{"id": user.ID})
 
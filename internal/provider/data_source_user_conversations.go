@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &UserConversationsDataSource{}
+
+func NewUserConversationsDataSource() datasource.DataSource {
+	return &UserConversationsDataSource{}
+}
+
+type UserConversationsDataSource struct {
+	queries slackExt.Queries
+}
+
+type UserConversationsDataSourceModel struct {
+	UserID             types.String                                       `tfsdk:"user_id"`
+	Types              types.Set                                          `tfsdk:"types"`
+	ExcludeArchived    types.Bool                                         `tfsdk:"exclude_archived"`
+	TeamID             types.String                                       `tfsdk:"team_id"`
+	TotalConversations types.Int64                                        `tfsdk:"total_conversations"`
+	Conversations      []UserConversationsDataSourceModelConversationItem `tfsdk:"conversations"`
+}
+
+type UserConversationsDataSourceModelConversationItem struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	IsPrivate  types.Bool   `tfsdk:"is_private"`
+	IsArchived types.Bool   `tfsdk:"is_archived"`
+}
+
+func (d *UserConversationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_conversations"
+}
+
+func (d *UserConversationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the conversations a Slack user is a member of. Without 'user_id', the conversations of the authenticated user or bot are returned.
+
+This datasource requires the following scopes:
+
+- channels:read (public channels)
+- groups:read (private channels)
+- im:read (optional)
+- mpim:read (optional)`,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user or bot user to list conversations of.",
+				Optional:            true,
+			},
+			"types": schema.SetAttribute{
+				MarkdownDescription: "Conversation types to include: `public_channel`, `private_channel`, `mpim` and/or `im`. Defaults to `public_channel`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("public_channel", "private_channel", "mpim", "im")),
+				},
+			},
+			"exclude_archived": schema.BoolAttribute{
+				MarkdownDescription: "If true, archived conversations are left out.",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to list conversations of. Required for org-level tokens.",
+				Optional:            true,
+			},
+			"total_conversations": schema.Int64Attribute{
+				Description: "Number of conversations returned.",
+				Computed:    true,
+			},
+			"conversations": schema.ListNestedAttribute{
+				Description: "List of conversations the user is a member of.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Conversation's Slack ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Conversation's name. Empty for direct messages.",
+							Computed:    true,
+						},
+						"is_private": schema.BoolAttribute{
+							Description: "True if the conversation is private.",
+							Computed:    true,
+						},
+						"is_archived": schema.BoolAttribute{
+							Description: "True if the conversation is archived.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserConversationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *UserConversationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserConversationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := slack.GetConversationsForUserParameters{
+		UserID:          data.UserID.ValueString(),
+		ExcludeArchived: data.ExcludeArchived.ValueBool(),
+		TeamID:          data.TeamID.ValueString(),
+	}
+	if !data.Types.IsNull() {
+		params.Types = setToStringSlice(data.Types)
+	}
+
+	channels, err := d.queries.GetAllConversationsForUser(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch conversations of user: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack user conversations", map[string]any{"total_conversations": len(channels)})
+
+	resultingList := []UserConversationsDataSourceModelConversationItem{}
+	for _, channel := range channels {
+		resultingList = append(resultingList, UserConversationsDataSourceModelConversationItem{
+			ID:         types.StringValue(channel.ID),
+			Name:       types.StringValue(channel.Name),
+			IsPrivate:  types.BoolValue(channel.IsPrivate),
+			IsArchived: types.BoolValue(channel.IsArchived),
+		})
+	}
+
+	data.Conversations = resultingList
+	data.TotalConversations = types.Int64Value(int64(len(resultingList)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_UserConversations(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			cA := tb.NewChannelBuilder().WithID("<ID_A>").WithName("<NAME_A>").WithIsPrivate(true).Build()
+			cB := tb.NewChannelBuilder().WithID("<ID_B>").WithName("<NAME_B>").Build()
+
+			expected_conversations_params := slack.GetConversationsForUserParameters{
+				UserID:          "<USER_ID>",
+				Types:           []string{"private_channel"},
+				ExcludeArchived: true,
+			}
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllConversationsForUser(gomock.Any(), expected_conversations_params).Return([]slack.Channel{*cA, *cB}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_conversations" "user" {
+				user_id          = "<USER_ID>"
+				types            = ["private_channel"]
+				exclude_archived = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "total_conversations", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "conversations.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "conversations.0.name", tb.ExpectString("<NAME_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "conversations.0.is_private", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "conversations.1.id", tb.ExpectString("<ID_B>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.user", "conversations.1.name", tb.ExpectString("<NAME_B>")),
+		),
+	})
+}
+
+func Test_DataSource_UserConversations_DefaultsToAuthenticatedUser(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			c := tb.NewChannelBuilder().WithID("<ID>").WithName("<NAME>").Build()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllConversationsForUser(gomock.Any(), slack.GetConversationsForUserParameters{}).Return([]slack.Channel{*c}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_conversations" "me" {}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.me", "total_conversations", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_user_conversations.me", "conversations.0.id", tb.ExpectString("<ID>")),
+		),
+	})
+}
+
+func Test_DataSource_UserConversations_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetAllConversationsForUser(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_conversations" "user" {
+				user_id = "<USER_ID>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_UserConversations_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := UserConversationsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/essent/terraform-provider-slack/internal/tb"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
 	"go.uber.org/mock/gomock"
 )
 
@@ -47,6 +48,79 @@ func Test_DataSource_User_ByEmail(t *testing.T) {
 	})
 }
 
+func Test_DataSource_User_ProfileAttributes(t *testing.T) {
+	// arrange
+	ub := tb.NewUserBuilder().WithID("<GIVEN_ID>").WithName("<NAME>").WithRealName("<REAL_NAME>").WithDisplayName("<DISPLAY_NAME>")
+	ub.WithTitle("<TITLE>").WithPhone("<PHONE>").WithTZ("<TZ>", 3600).WithTeamID("<TEAM_ID>").WithImage512("<IMAGE_512>")
+	ub.WithIsAdmin(tb.RandBool()).WithIsOwner(tb.RandBool()).WithIsBot(tb.RandBool()).WithIsRestricted(tb.RandBool())
+	ub.WithIsUltraRestricted(tb.RandBool()).WithIsAppUser(tb.RandBool())
+
+	u := ub.Build()
+
+	testConfig(t, tr.TestStep{
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserInfo(gomock.Any(), "<GIVEN_ID>").Return(u, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user" "user" {
+				id = "<GIVEN_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "real_name", tb.ExpectString("<REAL_NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "display_name", tb.ExpectString("<DISPLAY_NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "title", tb.ExpectString("<TITLE>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "phone", tb.ExpectString("<PHONE>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "tz", tb.ExpectString("<TZ>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "tz_offset", tb.ExpectString("3600")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "team_id", tb.ExpectString("<TEAM_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "image_512", tb.ExpectString("<IMAGE_512>")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_admin", tb.ExpectBool(u.IsAdmin)),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_owner", tb.ExpectBool(u.IsOwner)),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_bot", tb.ExpectBool(u.IsBot)),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_restricted", tb.ExpectBool(u.IsRestricted)),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_ultra_restricted", tb.ExpectBool(u.IsUltraRestricted)),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "is_app_user", tb.ExpectBool(u.IsAppUser)),
+			tr.TestCheckNoResourceAttr("data.slack_user.user", "custom_fields"),
+		),
+	})
+}
+
+func Test_DataSource_User_CustomFields(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			u := tb.NewUserBuilder().WithID("<GIVEN_ID>").WithName("<NAME>").Build()
+			p := tb.NewUserProfileBuilder().WithCustomField("<FIELD_ID>", "<FIELD_VALUE>").Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserInfo(gomock.Any(), "<GIVEN_ID>").Return(u, nil).AnyTimes()
+			m.EXPECT().GetUserProfile(gomock.Any(), &slack.GetUserProfileParameters{UserID: "<GIVEN_ID>"}).Return(p, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user" "user" {
+				id                    = "<GIVEN_ID>"
+				include_custom_fields = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "custom_fields.%", tb.ExpectString("1")),
+			tr.TestCheckResourceAttrWith("data.slack_user.user", "custom_fields.<FIELD_ID>", tb.ExpectString("<FIELD_VALUE>")),
+		),
+	})
+}
+
 func Test_DataSource_User_ByID(t *testing.T) {
 	testConfig(t, tr.TestStep{
 		// arrange
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &UserUserGroupsDataSource{}
+
+func NewUserUserGroupsDataSource() datasource.DataSource {
+	return &UserUserGroupsDataSource{}
+}
+
+type UserUserGroupsDataSource struct {
+	client slackExt.Client
+}
+
+type UserUserGroupsDataSourceModel struct {
+	UserID          types.String                             `tfsdk:"user_id"`
+	Email           types.String                             `tfsdk:"email"`
+	IncludeDisabled types.Bool                               `tfsdk:"include_disabled"`
+	UserGroupIDs    types.Set                                `tfsdk:"usergroup_ids"`
+	UserGroups      []UserUserGroupsDataSourceModelGroupItem `tfsdk:"usergroups"`
+}
+
+type UserUserGroupsDataSourceModelGroupItem struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Handle    types.String `tfsdk:"handle"`
+	IsEnabled types.Bool   `tfsdk:"is_enabled"`
+}
+
+func (d *UserUserGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_usergroups"
+}
+
+func (d *UserUserGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve the user groups a Slack user is a member of. Either 'user_id' or 'email' must be specified, but not both.
+
+This datasource requires the following scopes:
+
+- usergroups:read
+- users:read.email (when looking up by email)`,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user to look up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user to look up.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("user_id")),
+				},
+			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If true, disabled user groups are included as well.",
+				Optional:            true,
+			},
+			"usergroup_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the user groups the user is a member of.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"usergroups": schema.ListNestedAttribute{
+				Description: "User groups the user is a member of.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "User group's Slack ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the user group.",
+							Computed:    true,
+						},
+						"handle": schema.StringAttribute{
+							Description: "Handle of the user group.",
+							Computed:    true,
+						},
+						"is_enabled": schema.BoolAttribute{
+							Description: "True if the user group is enabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserUserGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *UserUserGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserUserGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UserID.IsNull() {
+		user, err := d.client.GetUserByEmail(ctx, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to fetch user info: %s", err),
+			)
+			return
+		}
+		data.UserID = types.StringValue(user.ID)
+	}
+
+	userGroups, err := d.client.GetUserGroups(ctx,
+		slack.GetUserGroupsOptionIncludeUsers(true),
+		slack.GetUserGroupsOptionIncludeDisabled(data.IncludeDisabled.ValueBool()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch Slack user groups: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Fetched Slack user groups", map[string]any{"total_usergroups": len(userGroups)})
+
+	ids := []string{}
+	resultingList := []UserUserGroupsDataSourceModelGroupItem{}
+	for _, group := range userGroups {
+		if !slices.Contains(group.Users, data.UserID.ValueString()) {
+			continue
+		}
+
+		ids = append(ids, group.ID)
+		resultingList = append(resultingList, UserUserGroupsDataSourceModelGroupItem{
+			ID:        types.StringValue(group.ID),
+			Name:      types.StringValue(group.Name),
+			Handle:    types.StringValue(group.Handle),
+			IsEnabled: types.BoolValue(group.DateDelete == 0),
+		})
+	}
+
+	data.UserGroupIDs = stringSliceToSet(ids)
+	data.UserGroups = resultingList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
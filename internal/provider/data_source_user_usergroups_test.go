@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_UserUsergroups(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			ugA := tb.NewUsergroupBuilder().WithID("<ID_A>").WithName("<NAME_A>").WithHandle("<HANDLE_A>").WithUsers([]string{"<USER_ID>", "<OTHER>"}).Build()
+			ugB := tb.NewUsergroupBuilder().WithID("<ID_B>").WithName("<NAME_B>").WithHandle("<HANDLE_B>").WithUsers([]string{"<OTHER>"}).Build()
+			ugC := tb.NewUsergroupBuilder().WithID("<ID_C>").WithName("<NAME_C>").WithHandle("<HANDLE_C>").WithUsers([]string{"<USER_ID>"}).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return([]slack.UserGroup{*ugA, *ugB, *ugC}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_usergroups" "user" {
+				user_id = "<USER_ID>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroup_ids.#", tb.ExpectString("2")),
+			tr.TestCheckTypeSetElemAttr("data.slack_user_usergroups.user", "usergroup_ids.*", "<ID_A>"),
+			tr.TestCheckTypeSetElemAttr("data.slack_user_usergroups.user", "usergroup_ids.*", "<ID_C>"),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.id", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.name", tb.ExpectString("<NAME_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.handle", tb.ExpectString("<HANDLE_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.is_enabled", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.1.id", tb.ExpectString("<ID_C>")),
+		),
+	})
+}
+
+func Test_DataSource_UserUsergroups_ByEmail(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			u := tb.NewUserBuilder().WithID("<USER_ID>").WithEmail("<GIVEN_EMAIL>").Build()
+			ug := tb.NewUsergroupBuilder().WithID("<ID>").WithUsers([]string{"<USER_ID>"}).WithDateDelete(1234567890).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserByEmail(gomock.Any(), "<GIVEN_EMAIL>").Return(u, nil).AnyTimes()
+			m.EXPECT().GetUserGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return([]slack.UserGroup{*ug}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_usergroups" "user" {
+				email            = "<GIVEN_EMAIL>"
+				include_disabled = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "user_id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.id", tb.ExpectString("<ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_user_usergroups.user", "usergroups.0.is_enabled", tb.ExpectBool(false)),
+		),
+	})
+}
+
+func Test_DataSource_UserUsergroups_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().GetUserGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_user_usergroups" "user" {
+				user_id = "<USER_ID>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_UserUsergroups_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := UserUserGroupsDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserGroupDataSource{}
+
+func NewUserGroupDataSource() datasource.DataSource {
+	return &UserGroupDataSource{}
+}
+
+type UserGroupDataSource struct {
+	queries slackExt.Queries
+}
+
+type UserGroupDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Handle          types.String `tfsdk:"handle"`
+	IncludeDisabled types.Bool   `tfsdk:"include_disabled"`
+	Description     types.String `tfsdk:"description"`
+	TeamID          types.String `tfsdk:"team_id"`
+	IsExternal      types.Bool   `tfsdk:"is_external"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	AutoType        types.String `tfsdk:"auto_type"`
+	DateCreate      types.Int64  `tfsdk:"date_create"`
+	DateUpdate      types.Int64  `tfsdk:"date_update"`
+	DateDelete      types.Int64  `tfsdk:"date_delete"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
+	DeletedBy       types.String `tfsdk:"deleted_by"`
+	Channels        types.List   `tfsdk:"channels"`
+	Users           types.Set    `tfsdk:"users"`
+	UserCount       types.Int64  `tfsdk:"user_count"`
+}
+
+func (d *UserGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+func (d *UserGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Retrieve a single Slack user group. Exactly one of 'id', 'name' or 'handle' must be specified.
+
+This datasource requires the following scopes:
+
+- usergroups:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User group ID to look up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("name"),
+						path.MatchRelative().AtParent().AtName("handle"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user group to look up (case-insensitive).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "Handle of the user group to look up (case-insensitive).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If true, disabled user groups are considered as well.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the user group.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace the user group belongs to.",
+				Computed:            true,
+			},
+			"is_external": schema.BoolAttribute{
+				MarkdownDescription: "True if the user group is external.",
+				Computed:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "True if the user group is enabled.",
+				Computed:            true,
+			},
+			"auto_type": schema.StringAttribute{
+				MarkdownDescription: "Type of an automatically managed user group, e.g. `admin` or `owner`. Empty for regular user groups.",
+				Computed:            true,
+			},
+			"date_create": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the user group was created.",
+				Computed:            true,
+			},
+			"date_update": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the user group was last updated.",
+				Computed:            true,
+			},
+			"date_delete": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp when the user group was disabled, 0 if it is enabled.",
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "User ID of the creator of the user group.",
+				Computed:            true,
+			},
+			"updated_by": schema.StringAttribute{
+				MarkdownDescription: "User ID of the member who last updated the user group.",
+				Computed:            true,
+			},
+			"deleted_by": schema.StringAttribute{
+				MarkdownDescription: "User ID of the member who disabled the user group.",
+				Computed:            true,
+			},
+			"channels": schema.ListAttribute{
+				MarkdownDescription: "Channels shared by the user group.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users in the user group.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"user_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of users in the user group.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *UserGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, value := "id", data.ID.ValueString()
+	if !data.Name.IsNull() {
+		field, value = "name", data.Name.ValueString()
+	} else if !data.Handle.IsNull() {
+		field, value = "handle", data.Handle.ValueString()
+	}
+
+	group, err := d.queries.FindUserGroupByField(ctx, field, value, data.IncludeDisabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fetch user group: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(group.ID)
+	data.Name = types.StringValue(group.Name)
+	data.Handle = types.StringValue(group.Handle)
+	data.Description = types.StringValue(group.Description)
+	data.TeamID = types.StringValue(group.TeamID)
+	data.IsExternal = types.BoolValue(group.IsExternal)
+	data.IsEnabled = types.BoolValue(group.DateDelete == 0)
+	data.AutoType = types.StringValue(group.AutoType)
+	data.DateCreate = types.Int64Value(int64(group.DateCreate))
+	data.DateUpdate = types.Int64Value(int64(group.DateUpdate))
+	data.DateDelete = types.Int64Value(int64(group.DateDelete))
+	data.CreatedBy = types.StringValue(group.CreatedBy)
+	data.UpdatedBy = types.StringValue(group.UpdatedBy)
+	data.DeletedBy = types.StringValue(group.DeletedBy)
+	data.Channels = stringSliceToList(group.Prefs.Channels)
+	data.Users = stringSliceToSet(group.Users)
+	data.UserCount = types.Int64Value(int64(group.UserCount))
+
+	tflog.Trace(ctx, "Fetched Slack user group", map[string]any{"id": group.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_Usergroup(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			ugb := tb.NewUsergroupBuilder().WithChannels([]string{"<CHANNEL_A>", "<CHANNEL_B>"}).WithUsers([]string{"<USER_A>", "<USER_B>"})
+			ugb.WithID("<ID>").WithName("<NAME>").WithDescription("<DESC>").WithHandle("<HANDLE>").WithTeamID("<TEAM_ID>").WithUserCount(2)
+			ug := ugb.Build()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUserGroupByField(gomock.Any(), "handle", "<HANDLE>", false).Return(*ug, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_usergroup" "group" {
+				handle = "<HANDLE>"
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "id", tb.ExpectString("<ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "name", tb.ExpectString("<NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "handle", tb.ExpectString("<HANDLE>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "description", tb.ExpectString("<DESC>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "team_id", tb.ExpectString("<TEAM_ID>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "is_enabled", tb.ExpectBool(true)),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "date_delete", tb.ExpectString("0")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "channels.0", tb.ExpectString("<CHANNEL_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "channels.1", tb.ExpectString("<CHANNEL_B>")),
+			tr.TestCheckTypeSetElemAttr("data.slack_usergroup.group", "users.*", "<USER_A>"),
+			tr.TestCheckTypeSetElemAttr("data.slack_usergroup.group", "users.*", "<USER_B>"),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "user_count", tb.ExpectString("2")),
+		),
+	})
+}
+
+func Test_DataSource_Usergroup_IncludeDisabled(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			ug := tb.NewUsergroupBuilder().WithID("<ID>").WithName("<NAME>").WithDateDelete(1234567890).Build()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUserGroupByField(gomock.Any(), "id", "<ID>", true).Return(*ug, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_usergroup" "group" {
+				id               = "<ID>"
+				include_disabled = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "name", tb.ExpectString("<NAME>")),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "is_enabled", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("data.slack_usergroup.group", "date_delete", tb.ExpectString("1234567890")),
+		),
+	})
+}
+
+func Test_DataSource_Usergroup_Error_When_NotFound(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUserGroupByField(gomock.Any(), "name", "<NAME>", false).Return(slack.UserGroup{}, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_usergroup" "group" {
+				name = "<NAME>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_Usergroup_Error_When_MultipleLookupsGiven(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_usergroup" "group" {
+				name   = "<NAME>"
+				handle = "<HANDLE>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	})
+}
+
+func Test_DataSource_Usergroup_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := UserGroupDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UsersByEmailDataSource{}
+
+func NewUsersByEmailDataSource() datasource.DataSource {
+	return &UsersByEmailDataSource{}
+}
+
+type UsersByEmailDataSource struct {
+	queries slackExt.Queries
+}
+
+type UsersByEmailDataSourceModel struct {
+	Emails types.Set `tfsdk:"emails"`
+	Users  types.Map `tfsdk:"users"`
+}
+
+func (d *UsersByEmailDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users_by_email"
+}
+
+func (d *UsersByEmailDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Resolve a set of emails to Slack user IDs. Fails if any email does not belong to an active Slack user.
+
+This datasource requires the following scopes:
+
+- users:read.email
+- users:read`,
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.SetAttribute{
+				MarkdownDescription: "Emails of the users to look up.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"users": schema.MapAttribute{
+				MarkdownDescription: "User IDs, keyed by email.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UsersByEmailDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.queries = providerData.Queries
+}
+
+func (d *UsersByEmailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersByEmailDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emails := setToStringSlice(data.Emails)
+	users, err := d.queries.FindUsersByEmail(ctx, emails)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to look up users by email: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Looked up Slack users by email", map[string]any{"emails": len(emails), "found": len(users)})
+
+	var unresolved []string
+	ids := make(map[string]attr.Value, len(emails))
+	for _, email := range emails {
+		user, ok := users[email]
+		switch {
+		case !ok:
+			unresolved = append(unresolved, fmt.Sprintf("- %s (not found)", email))
+		case user.Deleted:
+			unresolved = append(unresolved, fmt.Sprintf("- %s (deactivated)", email))
+		default:
+			ids[email] = types.StringValue(user.ID)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		sort.Strings(unresolved)
+		resp.Diagnostics.AddError(
+			"Unresolved Emails",
+			fmt.Sprintf("The following emails do not belong to active Slack users:\n\n%s", strings.Join(unresolved, "\n")),
+		)
+		return
+	}
+
+	data.Users = types.MapValueMust(types.StringType, ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
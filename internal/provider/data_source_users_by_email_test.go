@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DataSource_UsersByEmail(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithEmail("a@example.com").Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithEmail("b@example.com").Build()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUsersByEmail(gomock.Any(), gomock.InAnyOrder([]string{"a@example.com", "b@example.com"})).Return(map[string]slack.User{
+				"a@example.com": *uA,
+				"b@example.com": *uB,
+			}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_users_by_email" "users" {
+				emails = ["a@example.com", "b@example.com"]
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("data.slack_users_by_email.users", "users.%", tb.ExpectString("2")),
+			tr.TestCheckResourceAttrWith("data.slack_users_by_email.users", "users.a@example.com", tb.ExpectString("<ID_A>")),
+			tr.TestCheckResourceAttrWith("data.slack_users_by_email.users", "users.b@example.com", tb.ExpectString("<ID_B>")),
+		),
+	})
+}
+
+func Test_DataSource_UsersByEmail_Error_When_EmailsUnresolved(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			uA := tb.NewUserBuilder().WithID("<ID_A>").WithEmail("a@example.com").Build()
+			uB := tb.NewUserBuilder().WithID("<ID_B>").WithEmail("b@example.com").WithDeleted(true).Build()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUsersByEmail(gomock.Any(), gomock.Any()).Return(map[string]slack.User{
+				"a@example.com": *uA,
+				"b@example.com": *uB,
+			}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_users_by_email" "users" {
+				emails = ["a@example.com", "b@example.com", "c@example.com"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile(`(?s)b@example.com \(deactivated\).*c@example.com \(not found\)`),
+	})
+}
+
+func Test_DataSource_UsersByEmail_Error_When_RetrievalFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindUsersByEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			data "slack_users_by_email" "users" {
+				emails = ["a@example.com"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_DataSource_UsersByEmail_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &datasource.ConfigureResponse{}
+	req := datasource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := UsersByEmailDataSource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
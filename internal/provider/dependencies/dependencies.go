@@ -5,19 +5,18 @@ package dependencies
 
 import (
 	"github.com/essent/terraform-provider-slack/internal/slackExt"
-	"github.com/slack-go/slack"
 )
 
 type Dependencies interface {
-	CreateSlackClient(token string) slackExt.Client
+	CreateSlackClient(token string, options ...slackExt.Option) slackExt.Client
 	CreateSlackQueries(client slackExt.Client) slackExt.Queries
 }
 
 type dependenciesImpl struct {
 }
 
-func (d *dependenciesImpl) CreateSlackClient(token string) slackExt.Client {
-	return slackExt.New(slack.New(token))
+func (d *dependenciesImpl) CreateSlackClient(token string, options ...slackExt.Option) slackExt.Client {
+	return slackExt.New(token, options...)
 }
 
 func (d *dependenciesImpl) CreateSlackQueries(client slackExt.Client) slackExt.Queries {
@@ -29,12 +29,15 @@ type SlackProvider struct {
 }
 
 type SlackProviderModel struct {
-	SlackToken types.String `tfsdk:"slack_token"`
+	SlackToken   types.String `tfsdk:"slack_token"`
+	AuditLogsURL types.String `tfsdk:"audit_logs_url"`
 }
 
 type SlackProviderData struct {
 	Client           slackExt.Client
+	Queries          slackExt.Queries
 	UserGroupService UserGroupService
+	Identity         *slackExt.AuthIdentity
 }
 
 func (p *SlackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -51,6 +54,10 @@ func (p *SlackProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"audit_logs_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Slack Audit Logs API. Defaults to `https://api.slack.com/audit/v1/`; override it to run against a stand-in server.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -77,9 +84,14 @@ func (p *SlackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		slackToken = envToken
 	}
 
+	var options []slackExt.Option
+	if !data.AuditLogsURL.IsNull() {
+		options = append(options, slackExt.OptionAuditLogsURL(data.AuditLogsURL.ValueString()))
+	}
+
 	tflog.Info(ctx, "Configuring slack client")
-	client := p.dependencies.CreateSlackClient(slackToken)
-	_, err := client.AuthTest(ctx)
+	client := p.dependencies.CreateSlackClient(slackToken, options...)
+	identity, err := client.GetAuthIdentity(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Slack Token",
@@ -90,7 +102,9 @@ func (p *SlackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	providerData := &SlackProviderData{
 		Client:           client,
+		Queries:          p.dependencies.CreateSlackQueries(client),
 		UserGroupService: NewUserGroupService(client),
+		Identity:         identity,
 	}
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
@@ -99,6 +113,11 @@ func (p *SlackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 func (p *SlackProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserGroupResource,
+		NewUserStatusResource,
+		NewFileResource,
+		NewAdminConversationTeamsResource,
+		NewAdminRoleAssignmentResource,
+		NewAdminInformationBarrierResource,
 	}
 }
 
@@ -106,8 +125,23 @@ func (p *SlackProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewUserDataSource,
 		NewAllUsersDataSource,
+		NewUsersByEmailDataSource,
+		NewBillableUsersDataSource,
+		NewBotDataSource,
 		NewAllUserGroupsDataSource,
+		NewUserGroupDataSource,
+		NewUserUserGroupsDataSource,
 		NewConversationDataSource,
+		NewConversationsDataSource,
+		NewUserConversationsDataSource,
+		NewMessagePermalinkDataSource,
+		NewPinsDataSource,
+		NewTeamDataSource,
+		NewTeamProfileFieldsDataSource,
+		NewAuthIdentityDataSource,
+		NewEmojiDataSource,
+		NewAuditLogsDataSource,
+		NewAdminTeamsDataSource,
 	}
 }
 
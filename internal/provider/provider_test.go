@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/slack-go/slack"
 	"go.uber.org/mock/gomock"
 )
 
@@ -33,10 +32,14 @@ func testAccPreCheckWithSlackAuth(t *testing.T) {
 	testAccPreCheck(t)
 
 	m := tb.MockSlackClient()
-	m.EXPECT().AuthTest(gomock.Any()).Return(&slack.AuthTestResponse{}, nil).AnyTimes()
+	m.EXPECT().GetAuthIdentity(gomock.Any()).Return(tb.MockAuthIdentity(), nil).AnyTimes()
 }
 
 func testConfig(t *testing.T, step resource.TestStep) {
+	testConfigSteps(t, step)
+}
+
+func testConfigSteps(t *testing.T, steps ...resource.TestStep) {
 	defer tb.Finish()
 
 	resource.Test(t, resource.TestCase{
@@ -44,6 +47,6 @@ func testConfig(t *testing.T, step resource.TestStep) {
 			testAccPreCheckWithSlackAuth(t)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps:                    []resource.TestStep{step},
+		Steps:                    steps,
 	})
 }
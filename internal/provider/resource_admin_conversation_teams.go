@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                   = &AdminConversationTeamsResource{}
+	_ resource.ResourceWithImportState    = &AdminConversationTeamsResource{}
+	_ resource.ResourceWithValidateConfig = &AdminConversationTeamsResource{}
+)
+
+func NewAdminConversationTeamsResource() resource.Resource {
+	return &AdminConversationTeamsResource{}
+}
+
+type AdminConversationTeamsResource struct {
+	client  slackExt.Client
+	queries slackExt.Queries
+}
+
+type AdminConversationTeamsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ChannelID     types.String `tfsdk:"channel_id"`
+	TeamID        types.String `tfsdk:"team_id"`
+	OrgChannel    types.Bool   `tfsdk:"org_channel"`
+	TargetTeamIDs types.Set    `tfsdk:"target_team_ids"`
+}
+
+func (r *AdminConversationTeamsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_conversation_teams"
+}
+
+func (r *AdminConversationTeamsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the Enterprise Grid workspaces a channel is shared with. Either 'org_channel' must be true or 'target_team_ids' must be specified, but not both.
+
+The list of workspaces is authoritative: workspaces not listed are disconnected from the channel. When destroyed, the channel is restricted to 'team_id' again; without 'team_id' the channel is left as it is.
+
+This resource requires an org-level token with the following scopes:
+
+- admin.conversations:write
+- admin.conversations:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel to share.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace the channel belongs to. Omit for channels that are already shared across workspaces.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_channel": schema.BoolAttribute{
+				MarkdownDescription: "If true, the channel is shared with every workspace in the organization.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"target_team_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the workspaces the channel is shared with.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *AdminConversationTeamsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AdminConversationTeamsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.OrgChannel.IsUnknown() || config.TargetTeamIDs.IsUnknown() {
+		return
+	}
+
+	orgChannel := config.OrgChannel.ValueBool()
+	hasTargets := !config.TargetTeamIDs.IsNull()
+
+	if orgChannel && hasTargets {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_team_ids"),
+			"Invalid Attribute Combination",
+			"'target_team_ids' cannot be specified when 'org_channel' is true.",
+		)
+	}
+	if !orgChannel && !hasTargets {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_team_ids"),
+			"Invalid Attribute Combination",
+			"'target_team_ids' must be specified when 'org_channel' is not true.",
+		)
+	}
+}
+
+func (r *AdminConversationTeamsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.queries = providerData.Queries
+}
+
+func (r *AdminConversationTeamsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminConversationTeamsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setTeams(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+	plan.ID = plan.ChannelID
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminConversationTeamsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdminConversationTeamsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &state); err != nil {
+		if err.Error() == "channel_not_found" {
+			tflog.Warn(ctx, "Channel not found in Slack; removing from state", map[string]interface{}{
+				"channel_id": state.ChannelID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AdminConversationTeamsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AdminConversationTeamsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setTeams(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminConversationTeamsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdminConversationTeamsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TeamID.IsNull() {
+		tflog.Warn(ctx, "No team_id set; leaving channel shared as it is", map[string]interface{}{
+			"channel_id": state.ChannelID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	orgChannel := false
+	teamID := state.TeamID.ValueString()
+	err := r.client.AdminConversationsSetTeams(ctx, slack.AdminConversationsSetTeamsParams{
+		ChannelID:     state.ChannelID.ValueString(),
+		OrgChannel:    &orgChannel,
+		TargetTeamIDs: []string{teamID},
+		TeamID:        &teamID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Could not restrict channel %s to team %s: %s", state.ChannelID.ValueString(), teamID, err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AdminConversationTeamsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
+
+func (r *AdminConversationTeamsResource) setTeams(ctx context.Context, model *AdminConversationTeamsResourceModel) error {
+	orgChannel := model.OrgChannel.ValueBool()
+	params := slack.AdminConversationsSetTeamsParams{
+		ChannelID:  model.ChannelID.ValueString(),
+		OrgChannel: &orgChannel,
+	}
+	if !orgChannel {
+		params.TargetTeamIDs = setToStringSlice(model.TargetTeamIDs)
+	}
+	if !model.TeamID.IsNull() {
+		teamID := model.TeamID.ValueString()
+		params.TeamID = &teamID
+	}
+
+	if err := r.client.AdminConversationsSetTeams(ctx, params); err != nil {
+		return fmt.Errorf("could not set teams of channel %s: %w", params.ChannelID, err)
+	}
+	return nil
+}
+
+func (r *AdminConversationTeamsResource) readIntoModel(ctx context.Context, model *AdminConversationTeamsResourceModel) error {
+	model.ID = model.ChannelID
+	if model.OrgChannel.IsNull() {
+		model.OrgChannel = types.BoolValue(false)
+	}
+
+	// An org-wide channel is connected to every workspace, including future ones,
+	// so there is no list to compare against; only check that it is still shared org-wide.
+	if model.OrgChannel.ValueBool() {
+		channel, err := r.client.GetConversationInfo(ctx, &slack.GetConversationInfoInput{
+			ChannelID: model.ChannelID.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+		model.OrgChannel = types.BoolValue(channel.IsOrgShared)
+		model.TargetTeamIDs = types.SetNull(types.StringType)
+		return nil
+	}
+
+	teamIDs, err := r.queries.GetConversationTeams(ctx, model.ChannelID.ValueString())
+	if err != nil {
+		return err
+	}
+	model.TargetTeamIDs = stringSliceToSet(teamIDs)
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Resource_AdminConversationTeams(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			orgChannel := false
+			teamID := "<TEAM_A>"
+			expected_set_teams_params := slack.AdminConversationsSetTeamsParams{
+				ChannelID:     "<CHANNEL_ID>",
+				OrgChannel:    &orgChannel,
+				TargetTeamIDs: []string{"<TEAM_A>", "<TEAM_B>"},
+				TeamID:        &teamID,
+			}
+
+			expected_restore_params := slack.AdminConversationsSetTeamsParams{
+				ChannelID:     "<CHANNEL_ID>",
+				OrgChannel:    &orgChannel,
+				TargetTeamIDs: []string{"<TEAM_A>"},
+				TeamID:        &teamID,
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminConversationsSetTeams(gomock.Any(), expected_set_teams_params).Return(nil).AnyTimes()
+			m.EXPECT().AdminConversationsSetTeams(gomock.Any(), expected_restore_params).Return(nil).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetConversationTeams(gomock.Any(), "<CHANNEL_ID>").Return([]string{"<TEAM_A>", "<TEAM_B>"}, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_conversation_teams" "teams" {
+				channel_id      = "<CHANNEL_ID>"
+				team_id         = "<TEAM_A>"
+				target_team_ids = ["<TEAM_A>", "<TEAM_B>"]
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_admin_conversation_teams.teams", "id", tb.ExpectString("<CHANNEL_ID>")),
+			tr.TestCheckResourceAttrWith("slack_admin_conversation_teams.teams", "org_channel", tb.ExpectBool(false)),
+			tr.TestCheckResourceAttrWith("slack_admin_conversation_teams.teams", "target_team_ids.#", tb.ExpectString("2")),
+			tr.TestCheckTypeSetElemAttr("slack_admin_conversation_teams.teams", "target_team_ids.*", "<TEAM_A>"),
+			tr.TestCheckTypeSetElemAttr("slack_admin_conversation_teams.teams", "target_team_ids.*", "<TEAM_B>"),
+		),
+	})
+}
+
+func Test_Resource_AdminConversationTeams_OrgChannel(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			c := tb.NewChannelBuilder().WithID("<CHANNEL_ID>").WithIsOrgShared(true).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminConversationsSetTeams(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			m.EXPECT().GetConversationInfo(gomock.Any(), &slack.GetConversationInfoInput{ChannelID: "<CHANNEL_ID>"}).Return(c, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_conversation_teams" "teams" {
+				channel_id  = "<CHANNEL_ID>"
+				org_channel = true
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_admin_conversation_teams.teams", "org_channel", tb.ExpectBool(true)),
+			tr.TestCheckNoResourceAttr("slack_admin_conversation_teams.teams", "target_team_ids"),
+		),
+	})
+}
+
+func Test_Resource_AdminConversationTeams_Error_When_OrgChannelNotShared(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			c := tb.NewChannelBuilder().WithID("<CHANNEL_ID>").WithIsOrgShared(false).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminConversationsSetTeams(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			m.EXPECT().GetConversationInfo(gomock.Any(), &slack.GetConversationInfoInput{ChannelID: "<CHANNEL_ID>"}).Return(c, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_conversation_teams" "teams" {
+				channel_id  = "<CHANNEL_ID>"
+				org_channel = true
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Provider produced inconsistent result after apply"),
+	})
+}
+
+func Test_Resource_AdminConversationTeams_Error_When_OrgChannelAndTargetsSpecified(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_conversation_teams" "teams" {
+				channel_id      = "<CHANNEL_ID>"
+				org_channel     = true
+				target_team_ids = ["<TEAM_A>"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	})
+}
+
+func Test_Resource_AdminConversationTeams_Error_When_SetTeamsFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminConversationsSetTeams(gomock.Any(), gomock.Any()).Return(errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_conversation_teams" "teams" {
+				channel_id      = "<CHANNEL_ID>"
+				target_team_ids = ["<TEAM_A>"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_Resource_AdminConversationTeams_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &resource.ConfigureResponse{}
+	req := resource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := AdminConversationTeamsResource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &AdminInformationBarrierResource{}
+	_ resource.ResourceWithImportState = &AdminInformationBarrierResource{}
+)
+
+func NewAdminInformationBarrierResource() resource.Resource {
+	return &AdminInformationBarrierResource{}
+}
+
+type AdminInformationBarrierResource struct {
+	client  slackExt.Client
+	queries slackExt.Queries
+}
+
+type AdminInformationBarrierResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	PrimaryUserGroupID        types.String `tfsdk:"primary_usergroup_id"`
+	BarrieredFromUserGroupIDs types.Set    `tfsdk:"barriered_from_usergroup_ids"`
+	RestrictedSubjects        types.Set    `tfsdk:"restricted_subjects"`
+}
+
+func (r *AdminInformationBarrierResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_information_barrier"
+}
+
+func (r *AdminInformationBarrierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages an Enterprise Grid information barrier, which prevents the members of a primary user group from interacting with the members of other user groups.
+
+This resource requires an org-level token with the following scopes:
+
+- admin.barriers:write
+- admin.barriers:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user group the barrier applies to.",
+				Required:            true,
+			},
+			"barriered_from_usergroup_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the user groups the primary user group is barriered from.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"restricted_subjects": schema.SetAttribute{
+				MarkdownDescription: "Interactions that are restricted. Slack currently only accepts all of `im`, `mpim` and `call`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(stringSliceToSet([]string{"im", "mpim", "call"})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("im", "mpim", "call")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AdminInformationBarrierResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.queries = providerData.Queries
+}
+
+func (r *AdminInformationBarrierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminInformationBarrierResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	barrier, err := r.client.AdminBarriersCreate(ctx, toBarrierParams(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Could not create information barrier: %s", err))
+		return
+	}
+
+	plan.UpdateFromInformationBarrier(barrier)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminInformationBarrierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdminInformationBarrierResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	barrier, err := r.queries.FindInformationBarrierByID(ctx, state.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "no information barrier with id") {
+			tflog.Warn(ctx, "Information barrier not found in Slack; removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Could not read information barrier %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	state.UpdateFromInformationBarrier(&barrier)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AdminInformationBarrierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AdminInformationBarrierResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	barrier, err := r.client.AdminBarriersUpdate(ctx, state.ID.ValueString(), toBarrierParams(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Could not update information barrier %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	plan.UpdateFromInformationBarrier(barrier)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminInformationBarrierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdminInformationBarrierResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.AdminBarriersDelete(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Could not delete information barrier %s: %s", state.ID.ValueString(), err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AdminInformationBarrierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toBarrierParams(m *AdminInformationBarrierResourceModel) slackExt.AdminBarrierParams {
+	return slackExt.AdminBarrierParams{
+		PrimaryUserGroupID:        m.PrimaryUserGroupID.ValueString(),
+		BarrieredFromUserGroupIDs: setToStringSlice(m.BarrieredFromUserGroupIDs),
+		RestrictedSubjects:        setToStringSlice(m.RestrictedSubjects),
+	}
+}
+
+func (m *AdminInformationBarrierResourceModel) UpdateFromInformationBarrier(b *slackExt.InformationBarrier) {
+	barrieredFrom := make([]string, len(b.BarrieredFromUserGroups))
+	for i, g := range b.BarrieredFromUserGroups {
+		barrieredFrom[i] = g.ID
+	}
+
+	m.ID = types.StringValue(b.ID)
+	m.PrimaryUserGroupID = types.StringValue(b.PrimaryUserGroup.ID)
+	m.BarrieredFromUserGroupIDs = stringSliceToSet(barrieredFrom)
+	m.RestrictedSubjects = stringSliceToSet(b.RestrictedSubjects)
+}
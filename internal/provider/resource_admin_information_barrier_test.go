@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Resource_AdminInformationBarrier(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			b := slackExt.InformationBarrier{
+				ID:                      "<ID>",
+				PrimaryUserGroup:        slackExt.InformationBarrierGroup{ID: "<PRIMARY>"},
+				BarrieredFromUserGroups: []slackExt.InformationBarrierGroup{{ID: "<BARRIERED_A>"}, {ID: "<BARRIERED_B>"}},
+				RestrictedSubjects:      []string{"call", "im", "mpim"},
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminBarriersCreate(gomock.Any(), gomock.Any()).Return(&b, nil).AnyTimes()
+			m.EXPECT().AdminBarriersDelete(gomock.Any(), "<ID>").Return(nil).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().FindInformationBarrierByID(gomock.Any(), "<ID>").Return(b, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_information_barrier" "barrier" {
+				primary_usergroup_id         = "<PRIMARY>"
+				barriered_from_usergroup_ids = ["<BARRIERED_A>", "<BARRIERED_B>"]
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_admin_information_barrier.barrier", "id", tb.ExpectString("<ID>")),
+			tr.TestCheckResourceAttrWith("slack_admin_information_barrier.barrier", "primary_usergroup_id", tb.ExpectString("<PRIMARY>")),
+			tr.TestCheckTypeSetElemAttr("slack_admin_information_barrier.barrier", "barriered_from_usergroup_ids.*", "<BARRIERED_A>"),
+			tr.TestCheckTypeSetElemAttr("slack_admin_information_barrier.barrier", "barriered_from_usergroup_ids.*", "<BARRIERED_B>"),
+			tr.TestCheckResourceAttrWith("slack_admin_information_barrier.barrier", "restricted_subjects.#", tb.ExpectString("3")),
+		),
+	})
+}
+
+func Test_Resource_AdminInformationBarrier_Error_When_CreateFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminBarriersCreate(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_information_barrier" "barrier" {
+				primary_usergroup_id         = "<PRIMARY>"
+				barriered_from_usergroup_ids = ["<BARRIERED_A>"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_Resource_AdminInformationBarrier_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &resource.ConfigureResponse{}
+	req := resource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := AdminInformationBarrierResource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AdminRoleAssignmentResource{}
+	_ resource.ResourceWithImportState = &AdminRoleAssignmentResource{}
+)
+
+func NewAdminRoleAssignmentResource() resource.Resource {
+	return &AdminRoleAssignmentResource{}
+}
+
+type AdminRoleAssignmentResource struct {
+	client  slackExt.Client
+	queries slackExt.Queries
+}
+
+type AdminRoleAssignmentResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	RoleID   types.String `tfsdk:"role_id"`
+	EntityID types.String `tfsdk:"entity_id"`
+	UserIDs  types.Set    `tfsdk:"user_ids"`
+}
+
+func (r *AdminRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_role_assignment"
+}
+
+func (r *AdminRoleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Grants a Slack admin role to a set of users within a workspace, channel or organization.
+
+The resource is authoritative for the role and entity: users holding the role on the entity who are not listed are unassigned.
+
+This resource requires an org-level token with the following scopes:
+
+- admin.roles:write
+- admin.roles:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Role and entity ID, separated by a colon.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the role to assign, e.g. `Rl0A` for channel managers.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace, channel or organization the role applies to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users holding the role.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AdminRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil || providerData.Queries == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client and queries, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.queries = providerData.Queries
+}
+
+func (r *AdminRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.assignedUsers(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	if err := r.reconcile(ctx, &plan, current); err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdminRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AdminRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AdminRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, &plan, setToStringSlice(state.UserIDs)); err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AdminRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdminRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users := setToStringSlice(state.UserIDs)
+	if len(users) > 0 {
+		err := r.client.AdminRolesRemoveAssignments(ctx, slackExt.AdminRolesAssignmentsParams{
+			RoleID:    state.RoleID.ValueString(),
+			EntityIDs: []string{state.EntityID.ValueString()},
+			UserIDs:   users,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Could not remove role assignments: %s", err))
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AdminRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleID, entityID, ok := strings.Cut(req.ID, ":")
+	if !ok || roleID == "" || entityID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format <role_id>:<entity_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_id"), entityID)...)
+}
+
+// reconcile assigns the role to the planned users and removes it from every other user in current.
+func (r *AdminRoleAssignmentResource) reconcile(ctx context.Context, plan *AdminRoleAssignmentResourceModel, current []string) error {
+	planned := setToStringSlice(plan.UserIDs)
+	params := slackExt.AdminRolesAssignmentsParams{
+		RoleID:    plan.RoleID.ValueString(),
+		EntityIDs: []string{plan.EntityID.ValueString()},
+	}
+
+	if add := stringSliceDifference(planned, current); len(add) > 0 {
+		params.UserIDs = add
+		if err := r.client.AdminRolesAddAssignments(ctx, params); err != nil {
+			return fmt.Errorf("could not add role assignments: %w", err)
+		}
+	}
+
+	if remove := stringSliceDifference(current, planned); len(remove) > 0 {
+		params.UserIDs = remove
+		if err := r.client.AdminRolesRemoveAssignments(ctx, params); err != nil {
+			return fmt.Errorf("could not remove role assignments: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AdminRoleAssignmentResource) assignedUsers(ctx context.Context, model *AdminRoleAssignmentResourceModel) ([]string, error) {
+	assignments, err := r.queries.GetRoleAssignments(ctx, model.RoleID.ValueString(), model.EntityID.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("could not list role assignments: %w", err)
+	}
+
+	users := make([]string, 0, len(assignments))
+	for _, a := range assignments {
+		users = append(users, a.UserID)
+	}
+	return users, nil
+}
+
+func (r *AdminRoleAssignmentResource) readIntoModel(ctx context.Context, model *AdminRoleAssignmentResourceModel) error {
+	users, err := r.assignedUsers(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringValue(model.RoleID.ValueString() + ":" + model.EntityID.ValueString())
+	model.UserIDs = stringSliceToSet(users)
+	return nil
+}
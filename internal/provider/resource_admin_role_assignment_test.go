@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Resource_AdminRoleAssignment(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			assigned := []slackExt.RoleAssignment{
+				{RoleID: "<ROLE_ID>", EntityID: "<ENTITY_ID>", UserID: "<USER_A>"},
+				{RoleID: "<ROLE_ID>", EntityID: "<ENTITY_ID>", UserID: "<USER_B>"},
+			}
+			unmanaged := []slackExt.RoleAssignment{
+				{RoleID: "<ROLE_ID>", EntityID: "<ENTITY_ID>", UserID: "<USER_A>"},
+				{RoleID: "<ROLE_ID>", EntityID: "<ENTITY_ID>", UserID: "<USER_C>"},
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminRolesAddAssignments(gomock.Any(), slackExt.AdminRolesAssignmentsParams{
+				RoleID:    "<ROLE_ID>",
+				EntityIDs: []string{"<ENTITY_ID>"},
+				UserIDs:   []string{"<USER_B>"},
+			}).Return(nil).Times(1)
+			m.EXPECT().AdminRolesRemoveAssignments(gomock.Any(), slackExt.AdminRolesAssignmentsParams{
+				RoleID:    "<ROLE_ID>",
+				EntityIDs: []string{"<ENTITY_ID>"},
+				UserIDs:   []string{"<USER_C>"},
+			}).Return(nil).Times(1)
+			m.EXPECT().AdminRolesRemoveAssignments(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetRoleAssignments(gomock.Any(), "<ROLE_ID>", "<ENTITY_ID>").Return(unmanaged, nil).Times(1)
+			q.EXPECT().GetRoleAssignments(gomock.Any(), "<ROLE_ID>", "<ENTITY_ID>").Return(assigned, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_role_assignment" "managers" {
+				role_id   = "<ROLE_ID>"
+				entity_id = "<ENTITY_ID>"
+				user_ids  = ["<USER_A>", "<USER_B>"]
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_admin_role_assignment.managers", "id", tb.ExpectString("<ROLE_ID>:<ENTITY_ID>")),
+			tr.TestCheckResourceAttrWith("slack_admin_role_assignment.managers", "user_ids.#", tb.ExpectString("2")),
+			tr.TestCheckTypeSetElemAttr("slack_admin_role_assignment.managers", "user_ids.*", "<USER_A>"),
+			tr.TestCheckTypeSetElemAttr("slack_admin_role_assignment.managers", "user_ids.*", "<USER_B>"),
+		),
+	})
+}
+
+func Test_Resource_AdminRoleAssignment_Error_When_AddFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().AdminRolesAddAssignments(gomock.Any(), gomock.Any()).Return(errors.New("<SLACK_ERROR>")).AnyTimes()
+
+			q := tb.MockSlackQueries()
+			q.EXPECT().GetRoleAssignments(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_admin_role_assignment" "managers" {
+				role_id   = "<ROLE_ID>"
+				entity_id = "<ENTITY_ID>"
+				user_ids  = ["<USER_A>"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_Resource_AdminRoleAssignment_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &resource.ConfigureResponse{}
+	req := resource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := AdminRoleAssignmentResource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &FileResource{}
+	_ resource.ResourceWithModifyPlan = &FileResource{}
+)
+
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+type FileResource struct {
+	client slackExt.Client
+}
+
+type FileResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Source         types.String `tfsdk:"source"`
+	Filename       types.String `tfsdk:"filename"`
+	Title          types.String `tfsdk:"title"`
+	InitialComment types.String `tfsdk:"initial_comment"`
+	Channels       types.Set    `tfsdk:"channels"`
+	ContentSHA256  types.String `tfsdk:"content_sha256"`
+	Permalink      types.String `tfsdk:"permalink"`
+	URLPrivate     types.String `tfsdk:"url_private"`
+}
+
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *FileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Uploads a local file to Slack and shares it into channels.
+
+Slack files cannot be edited, so any change (including a change of the file content) uploads a new file and deletes the previous one.
+
+This resource requires the following scopes:
+
+- files:write
+- files:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the uploaded file.",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to the local file to upload.",
+				Required:            true,
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "Name of the file in Slack. Defaults to the base name of `source`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the file.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"initial_comment": schema.StringAttribute{
+				MarkdownDescription: "Message posted together with the file.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"channels": schema.SetAttribute{
+				MarkdownDescription: "IDs of the channels the file is shared to.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA256 checksum of the uploaded content. A different checksum of `source` triggers a new upload.",
+				Computed:            true,
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: "Permalink of the file.",
+				Computed:            true,
+			},
+			"url_private": schema.StringAttribute{
+				MarkdownDescription: "Private download URL of the file.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The source may be produced by another resource during apply.
+	if plan.Source.IsUnknown() {
+		return
+	}
+
+	if plan.Filename.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), filepath.Base(plan.Source.ValueString()))...)
+	}
+
+	checksum, err := fileSHA256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unreadable Source", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With an unchanged configuration the framework keeps the computed attributes of the state,
+	// but a changed content is uploaded as a new file.
+	if state.ContentSHA256.ValueString() != checksum {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permalink"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url_private"), types.StringUnknown())...)
+	}
+}
+
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.GetFileInfo(ctx, state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "file_not_found" || err.Error() == "file_deleted" {
+			tflog.Warn(ctx, "File not found in Slack; removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Could not read file %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	state.Permalink = types.StringValue(file.Permalink)
+	state.URLPrivate = types.StringValue(file.URLPrivate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	// Store the new file before removing the old one, so a failed delete does not lose track of the upload.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFile(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning("Delete Error", fmt.Sprintf("Could not delete previous file %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFile(ctx, state.ID.ValueString()); err != nil && err.Error() != "file_not_found" && err.Error() != "file_deleted" {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Could not delete file %s: %s", state.ID.ValueString(), err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *FileResource) upload(ctx context.Context, model *FileResourceModel) error {
+	source := model.Source.ValueString()
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return fmt.Errorf("could not read source: %w", err)
+	}
+
+	checksum, err := fileSHA256(source)
+	if err != nil {
+		return err
+	}
+
+	filename := model.Filename.ValueString()
+	if model.Filename.IsNull() || model.Filename.IsUnknown() {
+		filename = filepath.Base(source)
+	}
+
+	summary, err := r.client.UploadFile(ctx, slackExt.UploadFileParameters{
+		File:           source,
+		FileSize:       int(info.Size()),
+		Filename:       filename,
+		Title:          model.Title.ValueString(),
+		InitialComment: model.InitialComment.ValueString(),
+		Channels:       setToStringSlice(model.Channels),
+	})
+	if err != nil {
+		return fmt.Errorf("could not upload %s: %w", source, err)
+	}
+
+	file, err := r.client.GetFileInfo(ctx, summary.ID)
+	if err != nil {
+		return fmt.Errorf("could not read uploaded file %s: %w", summary.ID, err)
+	}
+
+	model.ID = types.StringValue(file.ID)
+	model.Filename = types.StringValue(filename)
+	model.ContentSHA256 = types.StringValue(checksum)
+	model.Permalink = types.StringValue(file.Permalink)
+	model.URLPrivate = types.StringValue(file.URLPrivate)
+	return nil
+}
+
+func fileSHA256(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func writeTestFile(t *testing.T, content string) string {
+	t.Helper()
+
+	name := filepath.Join(t.TempDir(), "diagram.txt")
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func Test_Resource_File(t *testing.T) {
+	source := writeTestFile(t, "<CONTENT>")
+
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			f := tb.NewFileBuilder().WithID("<ID>").WithName("diagram.txt").WithPermalink("<PERMALINK>").WithURLPrivate("<URL_PRIVATE>").Build()
+
+			expected_upload_parameters := slackExt.UploadFileParameters{
+				File:     source,
+				FileSize: len("<CONTENT>"),
+				Filename: "diagram.txt",
+				Title:    "<TITLE>",
+				Channels: []string{"<CHANNEL>"},
+			}
+
+			m := tb.MockSlackClient()
+			m.EXPECT().UploadFile(gomock.Any(), expected_upload_parameters).Return(&slack.FileSummary{ID: "<ID>"}, nil).AnyTimes()
+			m.EXPECT().GetFileInfo(gomock.Any(), "<ID>").Return(f, nil).AnyTimes()
+			m.EXPECT().DeleteFile(gomock.Any(), "<ID>").Return(nil).AnyTimes()
+		},
+		Config: fmt.Sprintf(`
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_file" "file" {
+				source   = %q
+				title    = "<TITLE>"
+				channels = ["<CHANNEL>"]
+			}
+		`, source),
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_file.file", "id", tb.ExpectString("<ID>")),
+			tr.TestCheckResourceAttrWith("slack_file.file", "filename", tb.ExpectString("diagram.txt")),
+			tr.TestCheckResourceAttrWith("slack_file.file", "permalink", tb.ExpectString("<PERMALINK>")),
+			tr.TestCheckResourceAttrWith("slack_file.file", "url_private", tb.ExpectString("<URL_PRIVATE>")),
+			tr.TestCheckResourceAttrWith("slack_file.file", "content_sha256", tb.ExpectString("5d5611e92a621e945ae0c64c1500872710e707581bba0be171e5028c2a0b251c")),
+		),
+	})
+}
+
+func Test_Resource_File_When_ContentChanged(t *testing.T) {
+	source := writeTestFile(t, "<CONTENT>")
+	config := fmt.Sprintf(`
+		provider slack {
+			slack_token = "<SLACK_TOKEN>"
+		}
+
+		resource "slack_file" "file" {
+			source   = %q
+			channels = ["<CHANNEL>"]
+		}
+	`, source)
+
+	testConfigSteps(t,
+		tr.TestStep{
+			// arrange
+			PreConfig: func() {
+				f := tb.NewFileBuilder().WithID("<ID>").WithName("diagram.txt").WithPermalink("<PERMALINK>").WithURLPrivate("<URL_PRIVATE>").Build()
+
+				expected_upload_parameters := slackExt.UploadFileParameters{
+					File:     source,
+					FileSize: len("<CONTENT>"),
+					Filename: "diagram.txt",
+					Channels: []string{"<CHANNEL>"},
+				}
+
+				m := tb.MockSlackClient()
+				m.EXPECT().UploadFile(gomock.Any(), expected_upload_parameters).Return(&slack.FileSummary{ID: "<ID>"}, nil).Times(1)
+				m.EXPECT().GetFileInfo(gomock.Any(), "<ID>").Return(f, nil).AnyTimes()
+				m.EXPECT().DeleteFile(gomock.Any(), "<ID>").Return(nil).Times(1)
+			},
+			Config: config,
+			// assert
+			Check: tr.TestCheckResourceAttrWith("slack_file.file", "id", tb.ExpectString("<ID>")),
+		},
+		tr.TestStep{
+			// arrange
+			PreConfig: func() {
+				if err := os.WriteFile(source, []byte("<NEW_CONTENT>"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+
+				f := tb.NewFileBuilder().WithID("<NEW_ID>").WithName("diagram.txt").WithPermalink("<NEW_PERMALINK>").WithURLPrivate("<NEW_URL_PRIVATE>").Build()
+
+				expected_upload_parameters := slackExt.UploadFileParameters{
+					File:     source,
+					FileSize: len("<NEW_CONTENT>"),
+					Filename: "diagram.txt",
+					Channels: []string{"<CHANNEL>"},
+				}
+
+				m := tb.MockSlackClient()
+				m.EXPECT().UploadFile(gomock.Any(), expected_upload_parameters).Return(&slack.FileSummary{ID: "<NEW_ID>"}, nil).Times(1)
+				m.EXPECT().GetFileInfo(gomock.Any(), "<NEW_ID>").Return(f, nil).AnyTimes()
+				m.EXPECT().DeleteFile(gomock.Any(), "<NEW_ID>").Return(nil).AnyTimes()
+			},
+			Config: config,
+			// assert
+			Check: tr.ComposeTestCheckFunc(
+				tr.TestCheckResourceAttrWith("slack_file.file", "id", tb.ExpectString("<NEW_ID>")),
+				tr.TestCheckResourceAttrWith("slack_file.file", "permalink", tb.ExpectString("<NEW_PERMALINK>")),
+				tr.TestCheckResourceAttrWith("slack_file.file", "url_private", tb.ExpectString("<NEW_URL_PRIVATE>")),
+				tr.TestCheckResourceAttrWith("slack_file.file", "content_sha256", tb.ExpectString("3d431ef0296f4fdffe50254cb3a07496ac0aaec4e4abf58f6d6795727510a919")),
+			),
+		},
+	)
+}
+
+func Test_Resource_File_Error_When_UploadFailed(t *testing.T) {
+	source := writeTestFile(t, "<CONTENT>")
+
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(nil, errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: fmt.Sprintf(`
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_file" "file" {
+				source   = %q
+				channels = ["<CHANNEL>"]
+			}
+		`, source),
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_Resource_File_Error_When_SourceMissing(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_file" "file" {
+				source   = "<MISSING_FILE>"
+				channels = ["<CHANNEL>"]
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Unreadable Source"),
+	})
+}
+
+func Test_Resource_File_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &resource.ConfigureResponse{}
+	req := resource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := FileResource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                = &UserStatusResource{}
+	_ resource.ResourceWithImportState = &UserStatusResource{}
+)
+
+func NewUserStatusResource() resource.Resource {
+	return &UserStatusResource{}
+}
+
+type UserStatusResource struct {
+	client slackExt.Client
+}
+
+type UserStatusResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	StatusText       types.String `tfsdk:"status_text"`
+	StatusEmoji      types.String `tfsdk:"status_emoji"`
+	StatusExpiration types.Int64  `tfsdk:"status_expiration"`
+}
+
+func (r *UserStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_status"
+}
+
+func (r *UserStatusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the custom status of a Slack user. Only the status fields of the profile are changed; all other profile fields are left untouched.
+
+Setting the status of another user requires an admin user token on a paid plan. Destroying the resource clears the status.
+
+This resource requires the following scopes:
+
+- users.profile:write
+- users.profile:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user whose status is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status_text": schema.StringAttribute{
+				MarkdownDescription: "Status text, e.g. `Automated account`.",
+				Required:            true,
+			},
+			"status_emoji": schema.StringAttribute{
+				MarkdownDescription: "Status emoji, e.g. `:robot_face:`. Slack picks `:speech_balloon:` when omitted.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status_expiration": schema.Int64Attribute{
+				MarkdownDescription: "UNIX timestamp at which the status expires. `0` means the status never expires. Once the expiration has passed, the cleared status is not reported as drift.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+		},
+	}
+}
+
+func (r *UserStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SlackProviderData)
+	if !ok || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected *SlackProviderData with initialized client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *UserStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserStatusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setStatus(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Could not set status of user %s: %s", plan.UserID.ValueString(), err))
+		return
+	}
+	plan.ID = plan.UserID
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserStatusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &state); err != nil {
+		if err.Error() == "user_not_found" {
+			tflog.Warn(ctx, "User not found in Slack; removing status from state", map[string]interface{}{
+				"user_id": state.UserID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserStatusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setStatus(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Could not set status of user %s: %s", plan.UserID.ValueString(), err))
+		return
+	}
+
+	if err := r.readIntoModel(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserStatusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetUserCustomStatus(ctx, state.UserID.ValueString(), "", "", 0); err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Could not clear status of user %s: %s", state.UserID.ValueString(), err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *UserStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
+
+func (r *UserStatusResource) setStatus(ctx context.Context, model *UserStatusResourceModel) error {
+	return r.client.SetUserCustomStatus(
+		ctx,
+		model.UserID.ValueString(),
+		model.StatusText.ValueString(),
+		model.StatusEmoji.ValueString(),
+		model.StatusExpiration.ValueInt64(),
+	)
+}
+
+func (r *UserStatusResource) readIntoModel(ctx context.Context, model *UserStatusResourceModel) error {
+	profile, err := r.client.GetUserProfile(ctx, &slack.GetUserProfileParameters{
+		UserID: model.UserID.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	model.ID = model.UserID
+
+	// Slack clears a status once its expiration has passed; that is the intended outcome, not drift.
+	// A status set by hand after the expiration is still reported.
+	expiration := model.StatusExpiration.ValueInt64()
+	expired := expiration > 0 && expiration <= time.Now().Unix()
+	cleared := profile.StatusText == "" && profile.StatusEmoji == "" && profile.StatusExpiration == 0
+	if expired && cleared {
+		if model.StatusEmoji.IsUnknown() {
+			model.StatusEmoji = types.StringValue(profile.StatusEmoji)
+		}
+		return nil
+	}
+
+	model.StatusText = types.StringValue(profile.StatusText)
+	model.StatusEmoji = types.StringValue(profile.StatusEmoji)
+	model.StatusExpiration = types.Int64Value(int64(profile.StatusExpiration))
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/essent/terraform-provider-slack/internal/tb"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tr "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Resource_UserStatus(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			p := tb.NewUserProfileBuilder().WithStatusText("<TEXT>").WithStatusEmoji("<EMOJI>").WithStatusExpiration(1234567890).Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "<TEXT>", "<EMOJI>", int64(1234567890)).Return(nil).AnyTimes()
+			m.EXPECT().GetUserProfile(gomock.Any(), &slack.GetUserProfileParameters{UserID: "<USER_ID>"}).Return(p, nil).AnyTimes()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "", "", int64(0)).Return(nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_user_status" "status" {
+				user_id           = "<USER_ID>"
+				status_text       = "<TEXT>"
+				status_emoji      = "<EMOJI>"
+				status_expiration = 1234567890
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "user_id", tb.ExpectString("<USER_ID>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_text", tb.ExpectString("<TEXT>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_emoji", tb.ExpectString("<EMOJI>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_expiration", tb.ExpectString("1234567890")),
+		),
+	})
+}
+
+func Test_Resource_UserStatus_When_Expired(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			p := tb.NewUserProfileBuilder().Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "<TEXT>", "<EMOJI>", int64(1234567890)).Return(nil).AnyTimes()
+			m.EXPECT().GetUserProfile(gomock.Any(), &slack.GetUserProfileParameters{UserID: "<USER_ID>"}).Return(p, nil).AnyTimes()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "", "", int64(0)).Return(nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_user_status" "status" {
+				user_id           = "<USER_ID>"
+				status_text       = "<TEXT>"
+				status_emoji      = "<EMOJI>"
+				status_expiration = 1234567890
+			}
+		`,
+		// assert
+		Check: tr.ComposeTestCheckFunc(
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_text", tb.ExpectString("<TEXT>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_emoji", tb.ExpectString("<EMOJI>")),
+			tr.TestCheckResourceAttrWith("slack_user_status.status", "status_expiration", tb.ExpectString("1234567890")),
+		),
+	})
+}
+
+func Test_Resource_UserStatus_When_ExpiredAndUpdated(t *testing.T) {
+	config := func(text string) string {
+		return fmt.Sprintf(`
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_user_status" "status" {
+				user_id           = "<USER_ID>"
+				status_text       = %q
+				status_expiration = 1234567890
+			}
+		`, text)
+	}
+
+	testConfigSteps(t,
+		tr.TestStep{
+			// arrange
+			PreConfig: func() {
+				p := tb.NewUserProfileBuilder().Build()
+
+				m := tb.MockSlackClient()
+				m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "<TEXT>", "", int64(1234567890)).Return(nil).AnyTimes()
+				m.EXPECT().GetUserProfile(gomock.Any(), &slack.GetUserProfileParameters{UserID: "<USER_ID>"}).Return(p, nil).AnyTimes()
+				m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "", "", int64(0)).Return(nil).AnyTimes()
+			},
+			Config: config("<TEXT>"),
+			// assert
+			Check: tr.ComposeTestCheckFunc(
+				tr.TestCheckResourceAttrWith("slack_user_status.status", "status_text", tb.ExpectString("<TEXT>")),
+				tr.TestCheckResourceAttrWith("slack_user_status.status", "status_emoji", tb.ExpectString("")),
+			),
+		},
+		tr.TestStep{
+			// arrange
+			PreConfig: func() {
+				m := tb.MockSlackClient()
+				m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "<NEW_TEXT>", "", int64(1234567890)).Return(nil).Times(1)
+			},
+			Config: config("<NEW_TEXT>"),
+			// assert
+			Check: tr.ComposeTestCheckFunc(
+				tr.TestCheckResourceAttrWith("slack_user_status.status", "status_text", tb.ExpectString("<NEW_TEXT>")),
+				tr.TestCheckResourceAttrWith("slack_user_status.status", "status_emoji", tb.ExpectString("")),
+				tr.TestCheckResourceAttrWith("slack_user_status.status", "status_expiration", tb.ExpectString("1234567890")),
+			),
+		},
+	)
+}
+
+func Test_Resource_UserStatus_When_SetAfterExpiration(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			p := tb.NewUserProfileBuilder().WithStatusText("<MANUAL_TEXT>").WithStatusEmoji("<MANUAL_EMOJI>").Build()
+
+			m := tb.MockSlackClient()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "<TEXT>", "", int64(1234567890)).Return(nil).AnyTimes()
+			m.EXPECT().GetUserProfile(gomock.Any(), &slack.GetUserProfileParameters{UserID: "<USER_ID>"}).Return(p, nil).AnyTimes()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), "<USER_ID>", "", "", int64(0)).Return(nil).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_user_status" "status" {
+				user_id           = "<USER_ID>"
+				status_text       = "<TEXT>"
+				status_expiration = 1234567890
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("Provider produced inconsistent result after apply"),
+	})
+}
+
+func Test_Resource_UserStatus_Error_When_SetFailed(t *testing.T) {
+	testConfig(t, tr.TestStep{
+		// arrange
+		PreConfig: func() {
+			m := tb.MockSlackClient()
+			m.EXPECT().SetUserCustomStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("<SLACK_ERROR>")).AnyTimes()
+		},
+		Config: `
+			provider slack {
+				slack_token = "<SLACK_TOKEN>"
+			}
+
+			resource "slack_user_status" "status" {
+				user_id     = "<USER_ID>"
+				status_text = "<TEXT>"
+			}
+		`,
+		// assert
+		ExpectError: regexp.MustCompile("<SLACK_ERROR>"),
+	})
+}
+
+func Test_Resource_UserStatus_Error_WhenSlackClientNil(t *testing.T) {
+	// arrange
+	res := &resource.ConfigureResponse{}
+	req := resource.ConfigureRequest{
+		ProviderData: &SlackProviderData{
+			Client: nil,
+		},
+	}
+
+	test_instance := UserStatusResource{}
+
+	// act
+	test_instance.Configure(context.Background(), req, res)
+
+	// assert
+	if res.Diagnostics.Errors()[0].Summary() != "Invalid Provider Data" {
+		t.Errorf("Expected error summary to be 'Invalid Provider Data', got: %s", res.Diagnostics.Errors()[0].Summary())
+	}
+}
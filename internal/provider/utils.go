@@ -57,6 +57,22 @@ func stringSliceToSet(list []string) types.Set {
 	return types.SetValueMust(types.StringType, attrValues)
 }
 
+// stringSliceDifference returns the elements of a that are not in b.
+func stringSliceDifference(a, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		exclude[v] = struct{}{}
+	}
+
+	var res []string
+	for _, v := range a {
+		if _, ok := exclude[v]; !ok {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
 func (m *UserGroupResourceModel) UpdateFromUserGroup(ug *slack.UserGroup) {
 	m.ID = types.StringValue(ug.ID)
 	m.Name = types.StringValue(ug.Name)
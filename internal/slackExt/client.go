@@ -5,25 +5,321 @@ package slackExt
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/slack-go/slack"
 )
 
 type Client interface {
-	AuthTest(ctx context.Context) (*slack.AuthTestResponse, error)
+	GetAuthIdentity(ctx context.Context) (*AuthIdentity, error)
 	GetUserInfo(ctx context.Context, user string) (*slack.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*slack.User, error)
+	GetBotInfo(ctx context.Context, params slack.GetBotInfoParameters) (*slack.Bot, error)
 	GetUsersContext(ctx context.Context) ([]slack.User, error)
 	GetUserGroups(ctx context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error)
 	GetConversationInfo(ctx context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error)
+	GetConversations(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error)
+	GetConversationsForUser(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error)
+	GetUserProfile(ctx context.Context, params *slack.GetUserProfileParameters) (*slack.UserProfile, error)
+	GetFileInfo(ctx context.Context, fileID string) (*slack.File, error)
+	GetPermalink(ctx context.Context, params *slack.PermalinkParameters) (string, error)
+	ListPins(ctx context.Context, channelID string) ([]PinnedItem, error)
+	GetTeamInfo(ctx context.Context, teamID string) (*TeamInfo, error)
+	GetTeamProfile(ctx context.Context, teamID string) (*TeamProfile, error)
+	GetBillableInfo(ctx context.Context, params BillableInfoParams) (map[string]bool, string, error)
+	GetEmoji(ctx context.Context, includeCategories bool) (*EmojiList, error)
+	AdminConversationsGetTeams(ctx context.Context, params AdminConversationsGetTeamsParams) ([]string, string, error)
+	AdminRolesListAssignments(ctx context.Context, params AdminRolesListAssignmentsParams) ([]RoleAssignment, string, error)
+	AdminBarriersList(ctx context.Context, params AdminBarriersListParams) ([]InformationBarrier, string, error)
+	AdminTeamsList(ctx context.Context, params AdminTeamsListParams) ([]AdminTeam, string, error)
+	GetAuditLogs(ctx context.Context, params AuditLogsParams) ([]AuditEntry, string, error)
 
 	CreateUserGroup(ctx context.Context, userGroup slack.UserGroup) (slack.UserGroup, error)
 	DisableUserGroup(ctx context.Context, userGroup string) (slack.UserGroup, error)
 	EnableUserGroup(ctx context.Context, userGroup string) (slack.UserGroup, error)
 	UpdateUserGroup(ctx context.Context, userGroupID string, options ...slack.UpdateUserGroupsOption) (slack.UserGroup, error)
 	UpdateUserGroupMembers(ctx context.Context, userGroup string, members string) (slack.UserGroup, error)
+	SetUserCustomStatus(ctx context.Context, user, statusText, statusEmoji string, statusExpiration int64) error
+	UploadFile(ctx context.Context, params UploadFileParameters) (*slack.FileSummary, error)
+	DeleteFile(ctx context.Context, fileID string) error
+	AdminConversationsSetTeams(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error
+	AdminRolesAddAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error
+	AdminRolesRemoveAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error
+	AdminBarriersCreate(ctx context.Context, params AdminBarrierParams) (*InformationBarrier, error)
+	AdminBarriersUpdate(ctx context.Context, barrierID string, params AdminBarrierParams) (*InformationBarrier, error)
+	AdminBarriersDelete(ctx context.Context, barrierID string) error
 }
 
-func New(base *slack.Client) Client {
-	return &clientRateLimit{&clientImpl{base}}
+// UploadFileParameters describes a file upload through files.getUploadURLExternal and
+// files.completeUploadExternal. Unlike slack-go, the file can be shared to several channels at once.
+type UploadFileParameters struct {
+	File           string
+	FileSize       int
+	Filename       string
+	Title          string
+	InitialComment string
+	Channels       []string
+}
+
+// AuthIdentity is the result of auth.test. Unlike slack.AuthTestResponse, it includes whether the app
+// is installed org-wide and the scopes granted to the token.
+type AuthIdentity struct {
+	URL                 string   `json:"url"`
+	Team                string   `json:"team"`
+	User                string   `json:"user"`
+	TeamID              string   `json:"team_id"`
+	UserID              string   `json:"user_id"`
+	BotID               string   `json:"bot_id"`
+	EnterpriseID        string   `json:"enterprise_id"`
+	IsEnterpriseInstall bool     `json:"is_enterprise_install"`
+	Scopes              []string `json:"-"`
+}
+
+// PinnedItem is an item returned by pins.list. Unlike slack.Item, it includes who pinned the item and when.
+type PinnedItem struct {
+	Type      string         `json:"type"`
+	Channel   string         `json:"channel"`
+	Created   int64          `json:"created"`
+	CreatedBy string         `json:"created_by"`
+	Message   *slack.Message `json:"message"`
+	File      *slack.File    `json:"file"`
+}
+
+// TeamInfo is the team object returned by team.info. Unlike slack.TeamInfo, it includes the
+// Enterprise Grid organization the workspace belongs to.
+type TeamInfo struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Domain         string   `json:"domain"`
+	EmailDomain    string   `json:"email_domain"`
+	Icon           TeamIcon `json:"icon"`
+	EnterpriseID   string   `json:"enterprise_id"`
+	EnterpriseName string   `json:"enterprise_name"`
+}
+
+type TeamIcon struct {
+	Image34      string `json:"image_34"`
+	Image44      string `json:"image_44"`
+	Image68      string `json:"image_68"`
+	Image88      string `json:"image_88"`
+	Image102     string `json:"image_102"`
+	Image132     string `json:"image_132"`
+	Image230     string `json:"image_230"`
+	ImageDefault bool   `json:"image_default"`
+}
+
+// TeamProfile is the result of team.profile.get. Unlike slack.TeamProfile, it includes the sections
+// the profile fields are grouped in.
+type TeamProfile struct {
+	Fields   []TeamProfileField   `json:"fields"`
+	Sections []TeamProfileSection `json:"sections"`
+}
+
+type TeamProfileField struct {
+	ID             string   `json:"id"`
+	Ordering       int      `json:"ordering"`
+	FieldName      string   `json:"field_name"`
+	Label          string   `json:"label"`
+	Hint           string   `json:"hint"`
+	Type           string   `json:"type"`
+	PossibleValues []string `json:"possible_values"`
+	IsHidden       bool     `json:"is_hidden"`
+	SectionID      string   `json:"section_id"`
+}
+
+type TeamProfileSection struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	SectionType string `json:"section_type"`
+	Order       int    `json:"order"`
+	IsHidden    bool   `json:"is_hidden"`
+}
+
+// BillableInfoParams contains arguments for one page of team.billableInfo.
+type BillableInfoParams struct {
+	TeamID string
+	Cursor string
+	Limit  int
+}
+
+// EmojiList is the result of emoji.list. Emoji maps custom emoji names to an image URL or to
+// "alias:<name>"; the categories list the names of the standard emoji.
+type EmojiList struct {
+	Emoji      map[string]string `json:"emoji"`
+	Categories []EmojiCategory   `json:"categories"`
+}
+
+type EmojiCategory struct {
+	Name       string   `json:"name"`
+	EmojiNames []string `json:"emoji_names"`
+}
+
+// AdminConversationsGetTeamsParams contains arguments for one page of admin.conversations.getTeams.
+type AdminConversationsGetTeamsParams struct {
+	ChannelID string
+	Cursor    string
+	Limit     int
+}
+
+// AdminRolesListAssignmentsParams contains arguments for one page of admin.roles.listAssignments.
+type AdminRolesListAssignmentsParams struct {
+	RoleIDs   []string
+	EntityIDs []string
+	Cursor    string
+	Limit     int
+}
+
+// AdminRolesAssignmentsParams contains arguments for admin.roles.addAssignments and admin.roles.removeAssignments.
+type AdminRolesAssignmentsParams struct {
+	RoleID    string
+	EntityIDs []string
+	UserIDs   []string
+}
+
+type RoleAssignment struct {
+	RoleID     string `json:"role_id"`
+	EntityID   string `json:"entity_id"`
+	UserID     string `json:"user_id"`
+	DateCreate int64  `json:"date_create"`
+}
+
+// AdminBarriersListParams contains arguments for one page of admin.barriers.list.
+type AdminBarriersListParams struct {
+	Cursor string
+	Limit  int
+}
+
+// AdminBarrierParams contains arguments for admin.barriers.create and admin.barriers.update.
+type AdminBarrierParams struct {
+	PrimaryUserGroupID        string
+	BarrieredFromUserGroupIDs []string
+	RestrictedSubjects        []string
+}
+
+type InformationBarrier struct {
+	ID                      string                    `json:"id"`
+	EnterpriseID            string                    `json:"enterprise_id"`
+	PrimaryUserGroup        InformationBarrierGroup   `json:"primary_usergroup"`
+	BarrieredFromUserGroups []InformationBarrierGroup `json:"barriered_from_usergroups"`
+	RestrictedSubjects      []string                  `json:"restricted_subjects"`
+	DateUpdate              int64                     `json:"date_update"`
+}
+
+type InformationBarrierGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// AdminTeamsListParams contains arguments for one page of admin.teams.list.
+type AdminTeamsListParams struct {
+	Cursor string
+	Limit  int
+}
+
+type AdminTeam struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Discoverability string         `json:"discoverability"`
+	PrimaryOwner    AdminTeamOwner `json:"primary_owner"`
+	TeamURL         string         `json:"team_url"`
+}
+
+type AdminTeamOwner struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// AuditLogsParams contains arguments for one page of the Audit Logs API. Oldest and Latest are UNIX timestamps.
+type AuditLogsParams struct {
+	Action string
+	Actor  string
+	Entity string
+	Oldest int64
+	Latest int64
+	Cursor string
+	Limit  int
+}
+
+// AuditEntry is an entry of the Audit Logs API. Unlike slack.AuditEntry, it covers every entity type
+// and keeps the action specific details as raw JSON.
+type AuditEntry struct {
+	ID         string          `json:"id"`
+	DateCreate int64           `json:"date_create"`
+	Action     string          `json:"action"`
+	Actor      AuditObject     `json:"actor"`
+	Entity     AuditObject     `json:"entity"`
+	Context    AuditContext    `json:"context"`
+	Details    json.RawMessage `json:"details"`
+}
+
+// AuditObject is the actor or entity of an audit entry. The API nests its attributes under a key
+// named after its type, e.g. {"type": "usergroup", "usergroup": {"id": "S0123"}}.
+type AuditObject struct {
+	Type   string
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Domain string `json:"domain"`
+}
+
+func (o *AuditObject) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var objectType string
+	if t, ok := raw["type"]; ok {
+		if err := json.Unmarshal(t, &objectType); err != nil {
+			return err
+		}
+	}
+
+	type attributes AuditObject
+	var a attributes
+	if nested, ok := raw[objectType]; ok {
+		if err := json.Unmarshal(nested, &a); err != nil {
+			return err
+		}
+	}
+
+	*o = AuditObject(a)
+	o.Type = objectType
+	return nil
+}
+
+type AuditContext struct {
+	Location  AuditLocation `json:"location"`
+	UA        string        `json:"ua"`
+	IPAddress string        `json:"ip_address"`
+	SessionID int64         `json:"session_id"`
+}
+
+type AuditLocation struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Domain string `json:"domain"`
+}
+
+// Option configures the client returned by New.
+type Option func(*clientImpl)
+
+// OptionAuditLogsURL overrides the base URL of the Audit Logs API, e.g. to run against a local stand-in server.
+func OptionAuditLogsURL(auditLogsURL string) Option {
+	return func(c *clientImpl) {
+		if !strings.HasSuffix(auditLogsURL, "/") {
+			auditLogsURL += "/"
+		}
+		c.audit.endpoint = auditLogsURL
+	}
+}
+
+func New(token string, options ...Option) Client {
+	c := &clientImpl{slack.New(token), newWebAPI(token), newAuditLogsAPI(token)}
+	for _, option := range options {
+		option(c)
+	}
+	return &clientRateLimit{c}
 }
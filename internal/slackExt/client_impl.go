@@ -5,16 +5,40 @@ package slackExt
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/slack-go/slack"
 )
 
 type clientImpl struct {
-	base *slack.Client
+	base  *slack.Client
+	api   *webAPI
+	audit *webAPI
 }
 
-func (c *clientImpl) AuthTest(ctx context.Context) (*slack.AuthTestResponse, error) {
-	return c.base.AuthTestContext(ctx)
+func (c *clientImpl) GetAuthIdentity(ctx context.Context) (*AuthIdentity, error) {
+	response := &struct {
+		slack.SlackResponse
+		AuthIdentity
+	}{}
+	header, err := c.api.postForm(ctx, "auth.test", url.Values{}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	// The granted scopes are only reported in a response header.
+	identity := response.AuthIdentity
+	identity.Scopes = []string{}
+	for _, scope := range strings.Split(header.Get("X-OAuth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			identity.Scopes = append(identity.Scopes, scope)
+		}
+	}
+	return &identity, nil
 }
 
 func (c *clientImpl) GetUserInfo(ctx context.Context, user string) (*slack.User, error) {
@@ -25,6 +49,10 @@ func (c *clientImpl) GetUserByEmail(ctx context.Context, email string) (*slack.U
 	return c.base.GetUserByEmailContext(ctx, email)
 }
 
+func (c *clientImpl) GetBotInfo(ctx context.Context, params slack.GetBotInfoParameters) (*slack.Bot, error) {
+	return c.base.GetBotInfoContext(ctx, params)
+}
+
 func (c *clientImpl) GetUsersContext(ctx context.Context) ([]slack.User, error) {
 	return c.base.GetUsersContext(ctx)
 }
@@ -37,6 +65,238 @@ func (c *clientImpl) GetConversationInfo(ctx context.Context, input *slack.GetCo
 	return c.base.GetConversationInfoContext(ctx, input)
 }
 
+func (c *clientImpl) GetConversations(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+	return c.base.GetConversationsContext(ctx, params)
+}
+
+func (c *clientImpl) GetConversationsForUser(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+	return c.base.GetConversationsForUserContext(ctx, params)
+}
+
+func (c *clientImpl) GetUserProfile(ctx context.Context, params *slack.GetUserProfileParameters) (*slack.UserProfile, error) {
+	return c.base.GetUserProfileContext(ctx, params)
+}
+
+func (c *clientImpl) GetFileInfo(ctx context.Context, fileID string) (*slack.File, error) {
+	file, _, _, err := c.base.GetFileInfoContext(ctx, fileID, 0, 0)
+	return file, err
+}
+
+func (c *clientImpl) GetPermalink(ctx context.Context, params *slack.PermalinkParameters) (string, error) {
+	return c.base.GetPermalinkContext(ctx, params)
+}
+
+func (c *clientImpl) ListPins(ctx context.Context, channelID string) ([]PinnedItem, error) {
+	response := &struct {
+		slack.SlackResponse
+		Items []PinnedItem `json:"items"`
+	}{}
+	if _, err := c.api.postForm(ctx, "pins.list", url.Values{"channel": {channelID}}, response); err != nil {
+		return nil, err
+	}
+
+	return response.Items, nil
+}
+
+func (c *clientImpl) GetTeamInfo(ctx context.Context, teamID string) (*TeamInfo, error) {
+	values := url.Values{}
+	if teamID != "" {
+		values.Set("team", teamID)
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		Team TeamInfo `json:"team"`
+	}{}
+	if _, err := c.api.postForm(ctx, "team.info", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.Team, nil
+}
+
+func (c *clientImpl) GetTeamProfile(ctx context.Context, teamID string) (*TeamProfile, error) {
+	values := url.Values{}
+	if teamID != "" {
+		values.Set("team_id", teamID)
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		Profile TeamProfile `json:"profile"`
+	}{}
+	if _, err := c.api.postForm(ctx, "team.profile.get", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.Profile, nil
+}
+
+func (c *clientImpl) GetBillableInfo(ctx context.Context, params BillableInfoParams) (map[string]bool, string, error) {
+	values := url.Values{}
+	if params.TeamID != "" {
+		values.Set("team_id", params.TeamID)
+	}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		BillableInfo map[string]slack.BillingActive `json:"billable_info"`
+	}{}
+	if _, err := c.api.postForm(ctx, "team.billableInfo", values, response); err != nil {
+		return nil, "", err
+	}
+
+	billingActive := make(map[string]bool, len(response.BillableInfo))
+	for userID, info := range response.BillableInfo {
+		billingActive[userID] = info.BillingActive
+	}
+	return billingActive, response.ResponseMetadata.Cursor, nil
+}
+
+func (c *clientImpl) GetEmoji(ctx context.Context, includeCategories bool) (*EmojiList, error) {
+	values := url.Values{}
+	if includeCategories {
+		values.Set("include_categories", "true")
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		EmojiList
+	}{}
+	if _, err := c.api.postForm(ctx, "emoji.list", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.EmojiList, nil
+}
+
+func (c *clientImpl) AdminConversationsGetTeams(ctx context.Context, params AdminConversationsGetTeamsParams) ([]string, string, error) {
+	values := url.Values{"channel_id": {params.ChannelID}}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		TeamIDs []string `json:"team_ids"`
+	}{}
+	if _, err := c.api.postForm(ctx, "admin.conversations.getTeams", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.TeamIDs, response.ResponseMetadata.Cursor, nil
+}
+
+func (c *clientImpl) AdminRolesListAssignments(ctx context.Context, params AdminRolesListAssignmentsParams) ([]RoleAssignment, string, error) {
+	values := url.Values{}
+	if len(params.RoleIDs) > 0 {
+		values.Set("role_ids", strings.Join(params.RoleIDs, ","))
+	}
+	if len(params.EntityIDs) > 0 {
+		values.Set("entity_ids", strings.Join(params.EntityIDs, ","))
+	}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		RoleAssignments []RoleAssignment `json:"role_assignments"`
+	}{}
+	if _, err := c.api.postForm(ctx, "admin.roles.listAssignments", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.RoleAssignments, response.ResponseMetadata.Cursor, nil
+}
+
+func (c *clientImpl) AdminBarriersList(ctx context.Context, params AdminBarriersListParams) ([]InformationBarrier, string, error) {
+	values := url.Values{}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		Barriers []InformationBarrier `json:"barriers"`
+	}{}
+	if _, err := c.api.postForm(ctx, "admin.barriers.list", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Barriers, response.ResponseMetadata.Cursor, nil
+}
+
+func (c *clientImpl) AdminTeamsList(ctx context.Context, params AdminTeamsListParams) ([]AdminTeam, string, error) {
+	values := url.Values{}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		Teams []AdminTeam `json:"teams"`
+	}{}
+	if _, err := c.api.postForm(ctx, "admin.teams.list", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Teams, response.ResponseMetadata.Cursor, nil
+}
+
+func (c *clientImpl) GetAuditLogs(ctx context.Context, params AuditLogsParams) ([]AuditEntry, string, error) {
+	values := url.Values{}
+	if params.Action != "" {
+		values.Set("action", params.Action)
+	}
+	if params.Actor != "" {
+		values.Set("actor", params.Actor)
+	}
+	if params.Entity != "" {
+		values.Set("entity", params.Entity)
+	}
+	if params.Oldest > 0 {
+		values.Set("oldest", strconv.FormatInt(params.Oldest, 10))
+	}
+	if params.Latest > 0 {
+		values.Set("latest", strconv.FormatInt(params.Latest, 10))
+	}
+	if params.Cursor != "" {
+		values.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &struct {
+		slack.SlackResponse
+		Entries []AuditEntry `json:"entries"`
+	}{}
+	if _, err := c.audit.get(ctx, "logs", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Entries, response.ResponseMetadata.Cursor, nil
+}
+
 func (c *clientImpl) CreateUserGroup(ctx context.Context, userGroup slack.UserGroup) (slack.UserGroup, error) {
 	return c.base.CreateUserGroupContext(ctx, userGroup)
 }
@@ -56,3 +316,120 @@ func (c *clientImpl) UpdateUserGroup(ctx context.Context, userGroupID string, op
 func (c *clientImpl) UpdateUserGroupMembers(ctx context.Context, userGroup string, members string) (slack.UserGroup, error) {
 	return c.base.UpdateUserGroupMembersContext(ctx, userGroup, members)
 }
+
+func (c *clientImpl) SetUserCustomStatus(ctx context.Context, user, statusText, statusEmoji string, statusExpiration int64) error {
+	return c.base.SetUserCustomStatusContextWithUser(ctx, user, statusText, statusEmoji, statusExpiration)
+}
+
+// UploadFile retries each step on its own when rate limited, so a throttled files.completeUploadExternal
+// does not request a new upload URL and upload the content again.
+func (c *clientImpl) UploadFile(ctx context.Context, params UploadFileParameters) (*slack.FileSummary, error) {
+	upload, err := rateLimit(ctx, func() (*slack.GetUploadURLExternalResponse, error) {
+		return c.base.GetUploadURLExternalContext(ctx, slack.GetUploadURLExternalParameters{
+			FileName: params.Filename,
+			FileSize: params.FileSize,
+		})
+	}, func() *slack.GetUploadURLExternalResponse { return nil })
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.UploadToURL(ctx, slack.UploadToURLParameters{
+			UploadURL: upload.UploadURL,
+			File:      params.File,
+			Filename:  params.Filename,
+		})
+	}, func() struct{} { return struct{}{} })
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := json.Marshal([]slack.FileSummary{{ID: upload.FileID, Title: params.Title}})
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{"files": {string(files)}}
+	if len(params.Channels) > 0 {
+		values.Set("channels", strings.Join(params.Channels, ","))
+	}
+	if params.InitialComment != "" {
+		values.Set("initial_comment", params.InitialComment)
+	}
+
+	response, err := rateLimit(ctx, func() (*slack.CompleteUploadExternalResponse, error) {
+		response := &slack.CompleteUploadExternalResponse{}
+		_, err := c.api.postForm(ctx, "files.completeUploadExternal", values, response)
+		return response, err
+	}, func() *slack.CompleteUploadExternalResponse { return nil })
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Files) != 1 {
+		return nil, fmt.Errorf("files.completeUploadExternal: expected 1 file, got %d", len(response.Files))
+	}
+
+	return &response.Files[0], nil
+}
+
+func (c *clientImpl) DeleteFile(ctx context.Context, fileID string) error {
+	return c.base.DeleteFileContext(ctx, fileID)
+}
+
+func (c *clientImpl) AdminConversationsSetTeams(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error {
+	return c.base.AdminConversationsSetTeams(ctx, params)
+}
+
+func (c *clientImpl) AdminRolesAddAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error {
+	return c.roleAssignments(ctx, "admin.roles.addAssignments", params)
+}
+
+func (c *clientImpl) AdminRolesRemoveAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error {
+	return c.roleAssignments(ctx, "admin.roles.removeAssignments", params)
+}
+
+func (c *clientImpl) roleAssignments(ctx context.Context, method string, params AdminRolesAssignmentsParams) error {
+	values := url.Values{
+		"role_id":    {params.RoleID},
+		"entity_ids": {strings.Join(params.EntityIDs, ",")},
+		"user_ids":   {strings.Join(params.UserIDs, ",")},
+	}
+
+	_, err := c.api.postForm(ctx, method, values, &slack.SlackResponse{})
+	return err
+}
+
+func (c *clientImpl) AdminBarriersCreate(ctx context.Context, params AdminBarrierParams) (*InformationBarrier, error) {
+	return c.barrier(ctx, "admin.barriers.create", barrierValues(params))
+}
+
+func (c *clientImpl) AdminBarriersUpdate(ctx context.Context, barrierID string, params AdminBarrierParams) (*InformationBarrier, error) {
+	values := barrierValues(params)
+	values.Set("barrier_id", barrierID)
+	return c.barrier(ctx, "admin.barriers.update", values)
+}
+
+func (c *clientImpl) AdminBarriersDelete(ctx context.Context, barrierID string) error {
+	_, err := c.api.postForm(ctx, "admin.barriers.delete", url.Values{"barrier_id": {barrierID}}, &slack.SlackResponse{})
+	return err
+}
+
+func (c *clientImpl) barrier(ctx context.Context, method string, values url.Values) (*InformationBarrier, error) {
+	response := &struct {
+		slack.SlackResponse
+		Barrier InformationBarrier `json:"barrier"`
+	}{}
+	if _, err := c.api.postForm(ctx, method, values, response); err != nil {
+		return nil, err
+	}
+	return &response.Barrier, nil
+}
+
+func barrierValues(params AdminBarrierParams) url.Values {
+	return url.Values{
+		"primary_usergroup_id":         {params.PrimaryUserGroupID},
+		"barriered_from_usergroup_ids": {strings.Join(params.BarrieredFromUserGroupIDs, ",")},
+		"restricted_subjects":          {strings.Join(params.RestrictedSubjects, ",")},
+	}
+}
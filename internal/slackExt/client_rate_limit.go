@@ -33,8 +33,10 @@ func rateLimit[R any](ctx context.Context, f func() (R, error), getZeroValue fun
 	}
 }
 
-func (c *clientRateLimit) AuthTest(ctx context.Context) (*slack.AuthTestResponse, error) {
-	return c.base.AuthTest(ctx)
+func (c *clientRateLimit) GetAuthIdentity(ctx context.Context) (*AuthIdentity, error) {
+	return rateLimit(ctx, func() (*AuthIdentity, error) {
+		return c.base.GetAuthIdentity(ctx)
+	}, func() *AuthIdentity { return nil })
 }
 
 func (c *clientRateLimit) GetUserInfo(ctx context.Context, user string) (result *slack.User, err error) {
@@ -49,6 +51,12 @@ func (c *clientRateLimit) GetUserByEmail(ctx context.Context, email string) (*sl
 	}, func() *slack.User { return nil })
 }
 
+func (c *clientRateLimit) GetBotInfo(ctx context.Context, params slack.GetBotInfoParameters) (*slack.Bot, error) {
+	return rateLimit(ctx, func() (*slack.Bot, error) {
+		return c.base.GetBotInfo(ctx, params)
+	}, func() *slack.Bot { return nil })
+}
+
 func (c *clientRateLimit) GetUsersContext(ctx context.Context) ([]slack.User, error) {
 	return c.base.GetUsersContext(ctx)
 }
@@ -65,6 +73,144 @@ func (c *clientRateLimit) GetConversationInfo(ctx context.Context, input *slack.
 	}, func() *slack.Channel { return nil })
 }
 
+func (c *clientRateLimit) GetConversations(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+	type page struct {
+		channels   []slack.Channel
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		channels, nextCursor, err := c.base.GetConversations(ctx, params)
+		return page{channels, nextCursor}, err
+	}, func() page { return page{} })
+	return result.channels, result.nextCursor, err
+}
+
+func (c *clientRateLimit) GetConversationsForUser(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+	type page struct {
+		channels   []slack.Channel
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		channels, nextCursor, err := c.base.GetConversationsForUser(ctx, params)
+		return page{channels, nextCursor}, err
+	}, func() page { return page{} })
+	return result.channels, result.nextCursor, err
+}
+
+func (c *clientRateLimit) GetUserProfile(ctx context.Context, params *slack.GetUserProfileParameters) (*slack.UserProfile, error) {
+	return rateLimit(ctx, func() (*slack.UserProfile, error) {
+		return c.base.GetUserProfile(ctx, params)
+	}, func() *slack.UserProfile { return nil })
+}
+
+func (c *clientRateLimit) GetFileInfo(ctx context.Context, fileID string) (*slack.File, error) {
+	return rateLimit(ctx, func() (*slack.File, error) {
+		return c.base.GetFileInfo(ctx, fileID)
+	}, func() *slack.File { return nil })
+}
+
+func (c *clientRateLimit) GetPermalink(ctx context.Context, params *slack.PermalinkParameters) (string, error) {
+	return rateLimit(ctx, func() (string, error) {
+		return c.base.GetPermalink(ctx, params)
+	}, func() string { return "" })
+}
+
+func (c *clientRateLimit) ListPins(ctx context.Context, channelID string) ([]PinnedItem, error) {
+	return rateLimit(ctx, func() ([]PinnedItem, error) {
+		return c.base.ListPins(ctx, channelID)
+	}, func() []PinnedItem { return nil })
+}
+
+func (c *clientRateLimit) GetTeamInfo(ctx context.Context, teamID string) (*TeamInfo, error) {
+	return rateLimit(ctx, func() (*TeamInfo, error) {
+		return c.base.GetTeamInfo(ctx, teamID)
+	}, func() *TeamInfo { return nil })
+}
+
+func (c *clientRateLimit) GetTeamProfile(ctx context.Context, teamID string) (*TeamProfile, error) {
+	return rateLimit(ctx, func() (*TeamProfile, error) {
+		return c.base.GetTeamProfile(ctx, teamID)
+	}, func() *TeamProfile { return nil })
+}
+
+func (c *clientRateLimit) GetBillableInfo(ctx context.Context, params BillableInfoParams) (map[string]bool, string, error) {
+	type page struct {
+		billingActive map[string]bool
+		nextCursor    string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		billingActive, nextCursor, err := c.base.GetBillableInfo(ctx, params)
+		return page{billingActive, nextCursor}, err
+	}, func() page { return page{} })
+	return result.billingActive, result.nextCursor, err
+}
+
+func (c *clientRateLimit) GetEmoji(ctx context.Context, includeCategories bool) (*EmojiList, error) {
+	return rateLimit(ctx, func() (*EmojiList, error) {
+		return c.base.GetEmoji(ctx, includeCategories)
+	}, func() *EmojiList { return nil })
+}
+
+func (c *clientRateLimit) AdminConversationsGetTeams(ctx context.Context, params AdminConversationsGetTeamsParams) ([]string, string, error) {
+	type page struct {
+		teamIDs    []string
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		teamIDs, nextCursor, err := c.base.AdminConversationsGetTeams(ctx, params)
+		return page{teamIDs, nextCursor}, err
+	}, func() page { return page{} })
+	return result.teamIDs, result.nextCursor, err
+}
+
+func (c *clientRateLimit) AdminRolesListAssignments(ctx context.Context, params AdminRolesListAssignmentsParams) ([]RoleAssignment, string, error) {
+	type page struct {
+		assignments []RoleAssignment
+		nextCursor  string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		assignments, nextCursor, err := c.base.AdminRolesListAssignments(ctx, params)
+		return page{assignments, nextCursor}, err
+	}, func() page { return page{} })
+	return result.assignments, result.nextCursor, err
+}
+
+func (c *clientRateLimit) AdminBarriersList(ctx context.Context, params AdminBarriersListParams) ([]InformationBarrier, string, error) {
+	type page struct {
+		barriers   []InformationBarrier
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		barriers, nextCursor, err := c.base.AdminBarriersList(ctx, params)
+		return page{barriers, nextCursor}, err
+	}, func() page { return page{} })
+	return result.barriers, result.nextCursor, err
+}
+
+func (c *clientRateLimit) AdminTeamsList(ctx context.Context, params AdminTeamsListParams) ([]AdminTeam, string, error) {
+	type page struct {
+		teams      []AdminTeam
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		teams, nextCursor, err := c.base.AdminTeamsList(ctx, params)
+		return page{teams, nextCursor}, err
+	}, func() page { return page{} })
+	return result.teams, result.nextCursor, err
+}
+
+func (c *clientRateLimit) GetAuditLogs(ctx context.Context, params AuditLogsParams) ([]AuditEntry, string, error) {
+	type page struct {
+		entries    []AuditEntry
+		nextCursor string
+	}
+	result, err := rateLimit(ctx, func() (page, error) {
+		entries, nextCursor, err := c.base.GetAuditLogs(ctx, params)
+		return page{entries, nextCursor}, err
+	}, func() page { return page{} })
+	return result.entries, result.nextCursor, err
+}
+
 func (c *clientRateLimit) CreateUserGroup(ctx context.Context, userGroup slack.UserGroup) (slack.UserGroup, error) {
 	return rateLimit(ctx, func() (slack.UserGroup, error) {
 		return c.base.CreateUserGroup(ctx, userGroup)
@@ -94,3 +240,62 @@ func (c *clientRateLimit) UpdateUserGroupMembers(ctx context.Context, userGroup
 		return c.base.UpdateUserGroupMembers(ctx, userGroup, members)
 	}, func() slack.UserGroup { return slack.UserGroup{} })
 }
+
+func (c *clientRateLimit) SetUserCustomStatus(ctx context.Context, user, statusText, statusEmoji string, statusExpiration int64) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.SetUserCustomStatus(ctx, user, statusText, statusEmoji, statusExpiration)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
+
+// UploadFile is not retried as a whole; the client retries each step of the upload separately.
+func (c *clientRateLimit) UploadFile(ctx context.Context, params UploadFileParameters) (*slack.FileSummary, error) {
+	return c.base.UploadFile(ctx, params)
+}
+
+func (c *clientRateLimit) DeleteFile(ctx context.Context, fileID string) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.DeleteFile(ctx, fileID)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
+
+func (c *clientRateLimit) AdminConversationsSetTeams(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.AdminConversationsSetTeams(ctx, params)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
+
+func (c *clientRateLimit) AdminRolesAddAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.AdminRolesAddAssignments(ctx, params)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
+
+func (c *clientRateLimit) AdminRolesRemoveAssignments(ctx context.Context, params AdminRolesAssignmentsParams) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.AdminRolesRemoveAssignments(ctx, params)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
+
+func (c *clientRateLimit) AdminBarriersCreate(ctx context.Context, params AdminBarrierParams) (*InformationBarrier, error) {
+	return rateLimit(ctx, func() (*InformationBarrier, error) {
+		return c.base.AdminBarriersCreate(ctx, params)
+	}, func() *InformationBarrier { return nil })
+}
+
+func (c *clientRateLimit) AdminBarriersUpdate(ctx context.Context, barrierID string, params AdminBarrierParams) (*InformationBarrier, error) {
+	return rateLimit(ctx, func() (*InformationBarrier, error) {
+		return c.base.AdminBarriersUpdate(ctx, barrierID, params)
+	}, func() *InformationBarrier { return nil })
+}
+
+func (c *clientRateLimit) AdminBarriersDelete(ctx context.Context, barrierID string) error {
+	_, err := rateLimit(ctx, func() (struct{}, error) {
+		return struct{}{}, c.base.AdminBarriersDelete(ctx, barrierID)
+	}, func() struct{} { return struct{}{} })
+	return err
+}
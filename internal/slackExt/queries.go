@@ -11,6 +11,16 @@ import (
 
 type Queries interface {
 	FindUserGroupByField(ctx context.Context, field, value string, includeDisabled bool) (slack.UserGroup, error)
+	FindUsersByEmail(ctx context.Context, emails []string) (map[string]slack.User, error)
+	GetAllConversations(ctx context.Context, params slack.GetConversationsParameters) ([]slack.Channel, error)
+	GetAllConversationsForUser(ctx context.Context, params slack.GetConversationsForUserParameters) ([]slack.Channel, error)
+	FindConversationByName(ctx context.Context, name string, params slack.GetConversationsParameters) (slack.Channel, error)
+	GetConversationTeams(ctx context.Context, channelID string) ([]string, error)
+	GetAllBillableInfo(ctx context.Context, teamID string) (map[string]bool, error)
+	GetRoleAssignments(ctx context.Context, roleID, entityID string) ([]RoleAssignment, error)
+	GetAllAdminTeams(ctx context.Context) ([]AdminTeam, error)
+	GetAllAuditLogs(ctx context.Context, params AuditLogsParams, maxEntries int) ([]AuditEntry, error)
+	FindInformationBarrierByID(ctx context.Context, barrierID string) (InformationBarrier, error)
 }
 
 func NewQueries(client Client) Queries {
@@ -7,10 +7,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/slack-go/slack"
 )
 
+const (
+	// usersListThreshold is the number of emails above which a single users.list pass
+	// is cheaper than looking up every email on its own.
+	usersListThreshold = 20
+	// lookupConcurrency bounds the number of parallel users.lookupByEmail calls.
+	lookupConcurrency = 5
+)
+
 type queriesImpl struct {
 	client Client
 }
@@ -48,3 +57,262 @@ func (q *queriesImpl) FindUserGroupByField(ctx context.Context, field, value str
 
 	return slack.UserGroup{}, fmt.Errorf("no usergroup with %s %q found", field, value)
 }
+
+// FindUsersByEmail resolves emails to users, including deactivated ones. The result is keyed by the
+// given emails; emails without a matching user are left out.
+func (q *queriesImpl) FindUsersByEmail(ctx context.Context, emails []string) (map[string]slack.User, error) {
+	if len(emails) > usersListThreshold {
+		return q.findUsersByEmailInList(ctx, emails)
+	}
+	return q.lookupUsersByEmail(ctx, emails)
+}
+
+func (q *queriesImpl) findUsersByEmailInList(ctx context.Context, emails []string) (map[string]slack.User, error) {
+	users, err := q.client.GetUsersContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail := make(map[string]slack.User, len(users))
+	for _, u := range users {
+		if u.Profile.Email != "" {
+			byEmail[strings.ToLower(u.Profile.Email)] = u
+		}
+	}
+
+	result := make(map[string]slack.User, len(emails))
+	for _, email := range emails {
+		if u, ok := byEmail[strings.ToLower(email)]; ok {
+			result[email] = u
+		}
+	}
+	return result, nil
+}
+
+func (q *queriesImpl) lookupUsersByEmail(ctx context.Context, emails []string) (map[string]slack.User, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		result   = make(map[string]slack.User, len(emails))
+		slots    = make(chan struct{}, lookupConcurrency)
+	)
+
+	for _, email := range emails {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			user, err := q.client.GetUserByEmail(ctx, email)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil && err.Error() == "users_not_found":
+			case err != nil:
+				if firstErr == nil {
+					firstErr = fmt.Errorf("could not look up %s: %w", email, err)
+				}
+			default:
+				result[email] = *user
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+func (q *queriesImpl) GetAllConversations(ctx context.Context, params slack.GetConversationsParameters) ([]slack.Channel, error) {
+	if params.Limit == 0 {
+		params.Limit = 1000
+	}
+
+	var channels []slack.Channel
+	for {
+		page, nextCursor, err := q.client.GetConversations(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, page...)
+
+		if nextCursor == "" {
+			return channels, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) GetAllConversationsForUser(ctx context.Context, params slack.GetConversationsForUserParameters) ([]slack.Channel, error) {
+	if params.Limit == 0 {
+		params.Limit = 1000
+	}
+
+	var channels []slack.Channel
+	for {
+		page, nextCursor, err := q.client.GetConversationsForUser(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, page...)
+
+		if nextCursor == "" {
+			return channels, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) FindConversationByName(ctx context.Context, name string, params slack.GetConversationsParameters) (slack.Channel, error) {
+	channels, err := q.GetAllConversations(ctx, params)
+	if err != nil {
+		return slack.Channel{}, err
+	}
+
+	var matches []slack.Channel
+	for _, c := range channels {
+		if c.Name == name {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return slack.Channel{}, fmt.Errorf("no conversation with name %q found", name)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, c := range matches {
+			ids[i] = c.ID
+		}
+		return slack.Channel{}, fmt.Errorf("conversation name %q is ambiguous, matching conversations: %s", name, strings.Join(ids, ", "))
+	}
+}
+
+func (q *queriesImpl) GetConversationTeams(ctx context.Context, channelID string) ([]string, error) {
+	var teamIDs []string
+	params := AdminConversationsGetTeamsParams{ChannelID: channelID, Limit: 1000}
+	for {
+		page, nextCursor, err := q.client.AdminConversationsGetTeams(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		teamIDs = append(teamIDs, page...)
+
+		if nextCursor == "" {
+			return teamIDs, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) GetAllBillableInfo(ctx context.Context, teamID string) (map[string]bool, error) {
+	billingActive := map[string]bool{}
+	params := BillableInfoParams{TeamID: teamID, Limit: 1000}
+	for {
+		page, nextCursor, err := q.client.GetBillableInfo(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for userID, active := range page {
+			billingActive[userID] = active
+		}
+
+		if nextCursor == "" {
+			return billingActive, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) GetRoleAssignments(ctx context.Context, roleID, entityID string) ([]RoleAssignment, error) {
+	var assignments []RoleAssignment
+	params := AdminRolesListAssignmentsParams{
+		RoleIDs:   []string{roleID},
+		EntityIDs: []string{entityID},
+		Limit:     200,
+	}
+	for {
+		page, nextCursor, err := q.client.AdminRolesListAssignments(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, page...)
+
+		if nextCursor == "" {
+			return assignments, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) GetAllAdminTeams(ctx context.Context) ([]AdminTeam, error) {
+	var teams []AdminTeam
+	params := AdminTeamsListParams{Limit: 100}
+	for {
+		page, nextCursor, err := q.client.AdminTeamsList(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, page...)
+
+		if nextCursor == "" {
+			return teams, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+// GetAllAuditLogs pages through the audit logs, newest first, until maxEntries entries are collected.
+// A maxEntries of 0 returns all entries.
+func (q *queriesImpl) GetAllAuditLogs(ctx context.Context, params AuditLogsParams, maxEntries int) ([]AuditEntry, error) {
+	if params.Limit == 0 {
+		params.Limit = 1000
+	}
+
+	var entries []AuditEntry
+	for {
+		page, nextCursor, err := q.client.GetAuditLogs(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page...)
+
+		if maxEntries > 0 && len(entries) >= maxEntries {
+			return entries[:maxEntries], nil
+		}
+		if nextCursor == "" {
+			return entries, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (q *queriesImpl) FindInformationBarrierByID(ctx context.Context, barrierID string) (InformationBarrier, error) {
+	params := AdminBarriersListParams{Limit: 100}
+	for {
+		page, nextCursor, err := q.client.AdminBarriersList(ctx, params)
+		if err != nil {
+			return InformationBarrier{}, err
+		}
+
+		for _, b := range page {
+			if b.ID == barrierID {
+				return b, nil
+			}
+		}
+
+		if nextCursor == "" {
+			return InformationBarrier{}, fmt.Errorf("no information barrier with id %q found", barrierID)
+		}
+		params.Cursor = nextCursor
+	}
+}
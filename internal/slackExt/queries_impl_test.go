@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package slackExt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func Test_Queries_GetAllAuditLogs(t *testing.T) {
+	// arrange
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+
+		if r.Header.Get("Authorization") != "Bearer <TOKEN>" {
+			t.Errorf("Expected bearer token, got: %s", r.Header.Get("Authorization"))
+		}
+
+		switch {
+		case len(requests) == 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case r.URL.Query().Get("cursor") == "":
+			_, _ = w.Write([]byte(`{
+				"entries": [{
+					"id": "<ENTRY_1>",
+					"date_create": 1700000100,
+					"action": "user_channel_join",
+					"actor": {"type": "user", "user": {"id": "<USER_ID>", "name": "<USER_NAME>", "email": "<USER_EMAIL>"}},
+					"entity": {"type": "channel", "channel": {"id": "<CHANNEL_ID>", "name": "<CHANNEL_NAME>"}},
+					"context": {"location": {"type": "workspace", "id": "<TEAM_ID>", "name": "<TEAM_NAME>", "domain": "<TEAM_DOMAIN>"}, "ua": "<UA>", "ip_address": "<IP>"}
+				}],
+				"response_metadata": {"next_cursor": "<CURSOR>"}
+			}`))
+		default:
+			_, _ = w.Write([]byte(`{
+				"entries": [{
+					"id": "<ENTRY_2>",
+					"date_create": 1700000000,
+					"action": "subteam_members_updated",
+					"actor": {"type": "user", "user": {"id": "<USER_ID>"}},
+					"entity": {"type": "usergroup", "usergroup": {"id": "<USERGROUP_ID>", "name": "<USERGROUP_NAME>"}},
+					"details": {"added_users": ["<USER_ID>"]}
+				}],
+				"response_metadata": {"next_cursor": ""}
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	test_instance := NewQueries(New("<TOKEN>", OptionAuditLogsURL(server.URL)))
+
+	// act
+	entries, err := test_instance.GetAllAuditLogs(context.Background(), AuditLogsParams{
+		Action: "user_channel_join,subteam_members_updated",
+		Actor:  "<USER_ID>",
+		Oldest: 1700000000,
+	}, 0)
+
+	// assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	expected_requests := []string{
+		"/logs?action=user_channel_join%2Csubteam_members_updated&actor=%3CUSER_ID%3E&limit=1000&oldest=1700000000",
+		"/logs?action=user_channel_join%2Csubteam_members_updated&actor=%3CUSER_ID%3E&limit=1000&oldest=1700000000",
+		"/logs?action=user_channel_join%2Csubteam_members_updated&actor=%3CUSER_ID%3E&cursor=%3CCURSOR%3E&limit=1000&oldest=1700000000",
+	}
+	if !reflect.DeepEqual(requests, expected_requests) {
+		t.Errorf("Expected requests %v, got: %v", expected_requests, requests)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got: %d", len(entries))
+	}
+
+	expected_actor := AuditObject{Type: "user", ID: "<USER_ID>", Name: "<USER_NAME>", Email: "<USER_EMAIL>"}
+	if entries[0].Actor != expected_actor {
+		t.Errorf("Expected actor %+v, got: %+v", expected_actor, entries[0].Actor)
+	}
+
+	expected_entity := AuditObject{Type: "channel", ID: "<CHANNEL_ID>", Name: "<CHANNEL_NAME>"}
+	if entries[0].Entity != expected_entity {
+		t.Errorf("Expected entity %+v, got: %+v", expected_entity, entries[0].Entity)
+	}
+
+	expected_location := AuditLocation{Type: "workspace", ID: "<TEAM_ID>", Name: "<TEAM_NAME>", Domain: "<TEAM_DOMAIN>"}
+	if entries[0].Context.Location != expected_location {
+		t.Errorf("Expected location %+v, got: %+v", expected_location, entries[0].Context.Location)
+	}
+
+	expected_usergroup := AuditObject{Type: "usergroup", ID: "<USERGROUP_ID>", Name: "<USERGROUP_NAME>"}
+	if entries[1].Entity != expected_usergroup {
+		t.Errorf("Expected entity %+v, got: %+v", expected_usergroup, entries[1].Entity)
+	}
+
+	if string(entries[1].Details) != `{"added_users": ["<USER_ID>"]}` {
+		t.Errorf("Expected raw details, got: %s", entries[1].Details)
+	}
+}
+
+func Test_Queries_GetAllAuditLogs_When_MaxEntriesReached(t *testing.T) {
+	// arrange
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{
+			"entries": [{"id": "<ENTRY_1>"}, {"id": "<ENTRY_2>"}],
+			"response_metadata": {"next_cursor": "<CURSOR>"}
+		}`))
+	}))
+	defer server.Close()
+
+	test_instance := NewQueries(New("<TOKEN>", OptionAuditLogsURL(server.URL)))
+
+	// act
+	entries, err := test_instance.GetAllAuditLogs(context.Background(), AuditLogsParams{}, 3)
+
+	// assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+	if len(entries) != 3 {
+		t.Errorf("Expected 3 entries, got: %d", len(entries))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got: %d", requests)
+	}
+}
+
+func Test_Queries_GetAllAuditLogs_Error_When_RequestFailed(t *testing.T) {
+	// arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": false, "error": "feature_not_enabled"}`))
+	}))
+	defer server.Close()
+
+	test_instance := NewQueries(New("<TOKEN>", OptionAuditLogsURL(server.URL)))
+
+	// act
+	_, err := test_instance.GetAllAuditLogs(context.Background(), AuditLogsParams{}, 0)
+
+	// assert
+	if err == nil || err.Error() != "feature_not_enabled" {
+		t.Errorf("Expected feature_not_enabled error, got: %v", err)
+	}
+}
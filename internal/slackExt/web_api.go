@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package slackExt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+const auditLogsAPIURL = "https://api.slack.com/audit/v1/"
+
+// webAPI calls Slack Web API methods (or method arguments) that slack-go does not cover.
+// Errors are reported the same way slack-go reports them, so callers can rely on
+// *slack.RateLimitedError and slack.SlackErrorResponse regardless of which client made the call.
+type webAPI struct {
+	token    string
+	endpoint string
+	http     *http.Client
+}
+
+type webAPIResponse interface {
+	Err() error
+}
+
+func newWebAPI(token string) *webAPI {
+	return &webAPI{
+		token:    token,
+		endpoint: slack.APIURL,
+		http:     &http.Client{},
+	}
+}
+
+// newAuditLogsAPI returns a webAPI for the Audit Logs API, which lives on its own base URL.
+func newAuditLogsAPI(token string) *webAPI {
+	return &webAPI{
+		token:    token,
+		endpoint: auditLogsAPIURL,
+		http:     &http.Client{},
+	}
+}
+
+func (w *webAPI) postForm(ctx context.Context, method string, values url.Values, response webAPIResponse) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.endpoint+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return w.do(req, method, response)
+}
+
+func (w *webAPI) get(ctx context.Context, method string, values url.Values, response webAPIResponse) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.endpoint+method+"?"+values.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.do(req, method, response)
+}
+
+func (w *webAPI) do(req *http.Request, method string, response webAPIResponse) (http.Header, error) {
+	req.Header.Set("Authorization", "Bearer "+w.token)
+
+	resp, err := w.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retry, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid Retry-After header: %w", method, err)
+		}
+		return nil, &slack.RateLimitedError{RetryAfter: time.Duration(retry) * time.Second}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("%s: could not decode response: %w", method, err)
+	}
+
+	return resp.Header, response.Err()
+}
@@ -18,16 +18,56 @@ func (b *ChannelBuilder) WithID(id string) *ChannelBuilder {
 	return b
 }
 
+func (b *ChannelBuilder) WithName(name string) *ChannelBuilder {
+	b.result.Name = name
+	return b
+}
+
+func (b *ChannelBuilder) WithNameNormalized(nameNormalized string) *ChannelBuilder {
+	b.result.NameNormalized = nameNormalized
+	return b
+}
+
+func (b *ChannelBuilder) WithIsPrivate(isPrivate bool) *ChannelBuilder {
+	b.result.IsPrivate = isPrivate
+	return b
+}
+
+func (b *ChannelBuilder) WithNumMembers(numMembers int) *ChannelBuilder {
+	b.result.NumMembers = numMembers
+	return b
+}
+
 func (b *ChannelBuilder) WithTopic(topic string) *ChannelBuilder {
 	b.result.Topic.Value = topic
 	return b
 }
 
+func (b *ChannelBuilder) WithTopicCreator(creator string) *ChannelBuilder {
+	b.result.Topic.Creator = creator
+	return b
+}
+
+func (b *ChannelBuilder) WithTopicLastSet(lastSet slack.JSONTime) *ChannelBuilder {
+	b.result.Topic.LastSet = lastSet
+	return b
+}
+
 func (b *ChannelBuilder) WithPurpose(purpose string) *ChannelBuilder {
 	b.result.Purpose.Value = purpose
 	return b
 }
 
+func (b *ChannelBuilder) WithPurposeCreator(creator string) *ChannelBuilder {
+	b.result.Purpose.Creator = creator
+	return b
+}
+
+func (b *ChannelBuilder) WithPurposeLastSet(lastSet slack.JSONTime) *ChannelBuilder {
+	b.result.Purpose.LastSet = lastSet
+	return b
+}
+
 func (b *ChannelBuilder) WithCreated(created slack.JSONTime) *ChannelBuilder {
 	b.result.Created = created
 	return b
@@ -63,6 +103,31 @@ func (b *ChannelBuilder) WithIsGeneral(isGeneral bool) *ChannelBuilder {
 	return b
 }
 
+func (b *ChannelBuilder) WithIsChannel(isChannel bool) *ChannelBuilder {
+	b.result.IsChannel = isChannel
+	return b
+}
+
+func (b *ChannelBuilder) WithIsIM(isIM bool) *ChannelBuilder {
+	b.result.IsIM = isIM
+	return b
+}
+
+func (b *ChannelBuilder) WithIsMpIM(isMpIM bool) *ChannelBuilder {
+	b.result.IsMpIM = isMpIM
+	return b
+}
+
+func (b *ChannelBuilder) WithLocale(locale string) *ChannelBuilder {
+	b.result.Locale = locale
+	return b
+}
+
+func (b *ChannelBuilder) WithConnectedTeamIDs(teamIDs ...string) *ChannelBuilder {
+	b.result.ConnectedTeamIDs = teamIDs
+	return b
+}
+
 func NewChannelBuilder() *ChannelBuilder {
 	return &ChannelBuilder{
 		result: &slack.Channel{},
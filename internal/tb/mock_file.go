@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tb
+
+import "github.com/slack-go/slack"
+
+type FileBuilder struct {
+	result *slack.File
+}
+
+func (b *FileBuilder) Build() *slack.File {
+	return b.result
+}
+
+func (b *FileBuilder) WithID(id string) *FileBuilder {
+	b.result.ID = id
+	return b
+}
+
+func (b *FileBuilder) WithName(name string) *FileBuilder {
+	b.result.Name = name
+	return b
+}
+
+func (b *FileBuilder) WithTitle(title string) *FileBuilder {
+	b.result.Title = title
+	return b
+}
+
+func (b *FileBuilder) WithPermalink(permalink string) *FileBuilder {
+	b.result.Permalink = permalink
+	return b
+}
+
+func (b *FileBuilder) WithURLPrivate(urlPrivate string) *FileBuilder {
+	b.result.URLPrivate = urlPrivate
+	return b
+}
+
+func NewFileBuilder() *FileBuilder {
+	return &FileBuilder{
+		result: &slack.File{},
+	}
+}
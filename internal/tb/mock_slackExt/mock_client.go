@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	slackExt "github.com/essent/terraform-provider-slack/internal/slackExt"
 	slack "github.com/slack-go/slack"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -35,19 +36,154 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// AuthTest mocks base method.
-func (m *MockClient) AuthTest(ctx context.Context) (*slack.AuthTestResponse, error) {
+// AdminBarriersCreate mocks base method.
+func (m *MockClient) AdminBarriersCreate(ctx context.Context, params slackExt.AdminBarrierParams) (*slackExt.InformationBarrier, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthTest", ctx)
-	ret0, _ := ret[0].(*slack.AuthTestResponse)
+	ret := m.ctrl.Call(m, "AdminBarriersCreate", ctx, params)
+	ret0, _ := ret[0].(*slackExt.InformationBarrier)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AuthTest indicates an expected call of AuthTest.
-func (mr *MockClientMockRecorder) AuthTest(ctx interface{}) *gomock.Call {
+// AdminBarriersCreate indicates an expected call of AdminBarriersCreate.
+func (mr *MockClientMockRecorder) AdminBarriersCreate(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTest", reflect.TypeOf((*MockClient)(nil).AuthTest), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminBarriersCreate", reflect.TypeOf((*MockClient)(nil).AdminBarriersCreate), ctx, params)
+}
+
+// AdminBarriersDelete mocks base method.
+func (m *MockClient) AdminBarriersDelete(ctx context.Context, barrierID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminBarriersDelete", ctx, barrierID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminBarriersDelete indicates an expected call of AdminBarriersDelete.
+func (mr *MockClientMockRecorder) AdminBarriersDelete(ctx, barrierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminBarriersDelete", reflect.TypeOf((*MockClient)(nil).AdminBarriersDelete), ctx, barrierID)
+}
+
+// AdminBarriersList mocks base method.
+func (m *MockClient) AdminBarriersList(ctx context.Context, params slackExt.AdminBarriersListParams) ([]slackExt.InformationBarrier, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminBarriersList", ctx, params)
+	ret0, _ := ret[0].([]slackExt.InformationBarrier)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AdminBarriersList indicates an expected call of AdminBarriersList.
+func (mr *MockClientMockRecorder) AdminBarriersList(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminBarriersList", reflect.TypeOf((*MockClient)(nil).AdminBarriersList), ctx, params)
+}
+
+// AdminBarriersUpdate mocks base method.
+func (m *MockClient) AdminBarriersUpdate(ctx context.Context, barrierID string, params slackExt.AdminBarrierParams) (*slackExt.InformationBarrier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminBarriersUpdate", ctx, barrierID, params)
+	ret0, _ := ret[0].(*slackExt.InformationBarrier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminBarriersUpdate indicates an expected call of AdminBarriersUpdate.
+func (mr *MockClientMockRecorder) AdminBarriersUpdate(ctx, barrierID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminBarriersUpdate", reflect.TypeOf((*MockClient)(nil).AdminBarriersUpdate), ctx, barrierID, params)
+}
+
+// AdminConversationsGetTeams mocks base method.
+func (m *MockClient) AdminConversationsGetTeams(ctx context.Context, params slackExt.AdminConversationsGetTeamsParams) ([]string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminConversationsGetTeams", ctx, params)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AdminConversationsGetTeams indicates an expected call of AdminConversationsGetTeams.
+func (mr *MockClientMockRecorder) AdminConversationsGetTeams(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminConversationsGetTeams", reflect.TypeOf((*MockClient)(nil).AdminConversationsGetTeams), ctx, params)
+}
+
+// AdminConversationsSetTeams mocks base method.
+func (m *MockClient) AdminConversationsSetTeams(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminConversationsSetTeams", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminConversationsSetTeams indicates an expected call of AdminConversationsSetTeams.
+func (mr *MockClientMockRecorder) AdminConversationsSetTeams(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminConversationsSetTeams", reflect.TypeOf((*MockClient)(nil).AdminConversationsSetTeams), ctx, params)
+}
+
+// AdminRolesAddAssignments mocks base method.
+func (m *MockClient) AdminRolesAddAssignments(ctx context.Context, params slackExt.AdminRolesAssignmentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminRolesAddAssignments", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminRolesAddAssignments indicates an expected call of AdminRolesAddAssignments.
+func (mr *MockClientMockRecorder) AdminRolesAddAssignments(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminRolesAddAssignments", reflect.TypeOf((*MockClient)(nil).AdminRolesAddAssignments), ctx, params)
+}
+
+// AdminRolesListAssignments mocks base method.
+func (m *MockClient) AdminRolesListAssignments(ctx context.Context, params slackExt.AdminRolesListAssignmentsParams) ([]slackExt.RoleAssignment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminRolesListAssignments", ctx, params)
+	ret0, _ := ret[0].([]slackExt.RoleAssignment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AdminRolesListAssignments indicates an expected call of AdminRolesListAssignments.
+func (mr *MockClientMockRecorder) AdminRolesListAssignments(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminRolesListAssignments", reflect.TypeOf((*MockClient)(nil).AdminRolesListAssignments), ctx, params)
+}
+
+// AdminRolesRemoveAssignments mocks base method.
+func (m *MockClient) AdminRolesRemoveAssignments(ctx context.Context, params slackExt.AdminRolesAssignmentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminRolesRemoveAssignments", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminRolesRemoveAssignments indicates an expected call of AdminRolesRemoveAssignments.
+func (mr *MockClientMockRecorder) AdminRolesRemoveAssignments(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminRolesRemoveAssignments", reflect.TypeOf((*MockClient)(nil).AdminRolesRemoveAssignments), ctx, params)
+}
+
+// AdminTeamsList mocks base method.
+func (m *MockClient) AdminTeamsList(ctx context.Context, params slackExt.AdminTeamsListParams) ([]slackExt.AdminTeam, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminTeamsList", ctx, params)
+	ret0, _ := ret[0].([]slackExt.AdminTeam)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AdminTeamsList indicates an expected call of AdminTeamsList.
+func (mr *MockClientMockRecorder) AdminTeamsList(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminTeamsList", reflect.TypeOf((*MockClient)(nil).AdminTeamsList), ctx, params)
 }
 
 // CreateUserGroup mocks base method.
@@ -65,6 +201,20 @@ func (mr *MockClientMockRecorder) CreateUserGroup(ctx, userGroup interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserGroup", reflect.TypeOf((*MockClient)(nil).CreateUserGroup), ctx, userGroup)
 }
 
+// DeleteFile mocks base method.
+func (m *MockClient) DeleteFile(ctx context.Context, fileID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockClientMockRecorder) DeleteFile(ctx, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockClient)(nil).DeleteFile), ctx, fileID)
+}
+
 // DisableUserGroup mocks base method.
 func (m *MockClient) DisableUserGroup(ctx context.Context, userGroup string) (slack.UserGroup, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +245,68 @@ func (mr *MockClientMockRecorder) EnableUserGroup(ctx, userGroup interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserGroup", reflect.TypeOf((*MockClient)(nil).EnableUserGroup), ctx, userGroup)
 }
 
+// GetAuditLogs mocks base method.
+func (m *MockClient) GetAuditLogs(ctx context.Context, params slackExt.AuditLogsParams) ([]slackExt.AuditEntry, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogs", ctx, params)
+	ret0, _ := ret[0].([]slackExt.AuditEntry)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAuditLogs indicates an expected call of GetAuditLogs.
+func (mr *MockClientMockRecorder) GetAuditLogs(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockClient)(nil).GetAuditLogs), ctx, params)
+}
+
+// GetAuthIdentity mocks base method.
+func (m *MockClient) GetAuthIdentity(ctx context.Context) (*slackExt.AuthIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthIdentity", ctx)
+	ret0, _ := ret[0].(*slackExt.AuthIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthIdentity indicates an expected call of GetAuthIdentity.
+func (mr *MockClientMockRecorder) GetAuthIdentity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthIdentity", reflect.TypeOf((*MockClient)(nil).GetAuthIdentity), ctx)
+}
+
+// GetBillableInfo mocks base method.
+func (m *MockClient) GetBillableInfo(ctx context.Context, params slackExt.BillableInfoParams) (map[string]bool, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillableInfo", ctx, params)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBillableInfo indicates an expected call of GetBillableInfo.
+func (mr *MockClientMockRecorder) GetBillableInfo(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillableInfo", reflect.TypeOf((*MockClient)(nil).GetBillableInfo), ctx, params)
+}
+
+// GetBotInfo mocks base method.
+func (m *MockClient) GetBotInfo(ctx context.Context, params slack.GetBotInfoParameters) (*slack.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBotInfo", ctx, params)
+	ret0, _ := ret[0].(*slack.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBotInfo indicates an expected call of GetBotInfo.
+func (mr *MockClientMockRecorder) GetBotInfo(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBotInfo", reflect.TypeOf((*MockClient)(nil).GetBotInfo), ctx, params)
+}
+
 // GetConversationInfo mocks base method.
 func (m *MockClient) GetConversationInfo(ctx context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +322,113 @@ func (mr *MockClientMockRecorder) GetConversationInfo(ctx, input interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationInfo", reflect.TypeOf((*MockClient)(nil).GetConversationInfo), ctx, input)
 }
 
+// GetConversations mocks base method.
+func (m *MockClient) GetConversations(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversations", ctx, params)
+	ret0, _ := ret[0].([]slack.Channel)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConversations indicates an expected call of GetConversations.
+func (mr *MockClientMockRecorder) GetConversations(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversations", reflect.TypeOf((*MockClient)(nil).GetConversations), ctx, params)
+}
+
+// GetConversationsForUser mocks base method.
+func (m *MockClient) GetConversationsForUser(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationsForUser", ctx, params)
+	ret0, _ := ret[0].([]slack.Channel)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConversationsForUser indicates an expected call of GetConversationsForUser.
+func (mr *MockClientMockRecorder) GetConversationsForUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationsForUser", reflect.TypeOf((*MockClient)(nil).GetConversationsForUser), ctx, params)
+}
+
+// GetEmoji mocks base method.
+func (m *MockClient) GetEmoji(ctx context.Context, includeCategories bool) (*slackExt.EmojiList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmoji", ctx, includeCategories)
+	ret0, _ := ret[0].(*slackExt.EmojiList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmoji indicates an expected call of GetEmoji.
+func (mr *MockClientMockRecorder) GetEmoji(ctx, includeCategories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmoji", reflect.TypeOf((*MockClient)(nil).GetEmoji), ctx, includeCategories)
+}
+
+// GetFileInfo mocks base method.
+func (m *MockClient) GetFileInfo(ctx context.Context, fileID string) (*slack.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileInfo", ctx, fileID)
+	ret0, _ := ret[0].(*slack.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileInfo indicates an expected call of GetFileInfo.
+func (mr *MockClientMockRecorder) GetFileInfo(ctx, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockClient)(nil).GetFileInfo), ctx, fileID)
+}
+
+// GetPermalink mocks base method.
+func (m *MockClient) GetPermalink(ctx context.Context, params *slack.PermalinkParameters) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermalink", ctx, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermalink indicates an expected call of GetPermalink.
+func (mr *MockClientMockRecorder) GetPermalink(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermalink", reflect.TypeOf((*MockClient)(nil).GetPermalink), ctx, params)
+}
+
+// GetTeamInfo mocks base method.
+func (m *MockClient) GetTeamInfo(ctx context.Context, teamID string) (*slackExt.TeamInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamInfo", ctx, teamID)
+	ret0, _ := ret[0].(*slackExt.TeamInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamInfo indicates an expected call of GetTeamInfo.
+func (mr *MockClientMockRecorder) GetTeamInfo(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamInfo", reflect.TypeOf((*MockClient)(nil).GetTeamInfo), ctx, teamID)
+}
+
+// GetTeamProfile mocks base method.
+func (m *MockClient) GetTeamProfile(ctx context.Context, teamID string) (*slackExt.TeamProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamProfile", ctx, teamID)
+	ret0, _ := ret[0].(*slackExt.TeamProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamProfile indicates an expected call of GetTeamProfile.
+func (mr *MockClientMockRecorder) GetTeamProfile(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamProfile", reflect.TypeOf((*MockClient)(nil).GetTeamProfile), ctx, teamID)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockClient) GetUserByEmail(ctx context.Context, email string) (*slack.User, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +479,21 @@ func (mr *MockClientMockRecorder) GetUserInfo(ctx, user interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockClient)(nil).GetUserInfo), ctx, user)
 }
 
+// GetUserProfile mocks base method.
+func (m *MockClient) GetUserProfile(ctx context.Context, params *slack.GetUserProfileParameters) (*slack.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", ctx, params)
+	ret0, _ := ret[0].(*slack.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockClientMockRecorder) GetUserProfile(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockClient)(nil).GetUserProfile), ctx, params)
+}
+
 // GetUsersContext mocks base method.
 func (m *MockClient) GetUsersContext(ctx context.Context) ([]slack.User, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +509,35 @@ func (mr *MockClientMockRecorder) GetUsersContext(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersContext", reflect.TypeOf((*MockClient)(nil).GetUsersContext), ctx)
 }
 
+// ListPins mocks base method.
+func (m *MockClient) ListPins(ctx context.Context, channelID string) ([]slackExt.PinnedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPins", ctx, channelID)
+	ret0, _ := ret[0].([]slackExt.PinnedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPins indicates an expected call of ListPins.
+func (mr *MockClientMockRecorder) ListPins(ctx, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPins", reflect.TypeOf((*MockClient)(nil).ListPins), ctx, channelID)
+}
+
+// SetUserCustomStatus mocks base method.
+func (m *MockClient) SetUserCustomStatus(ctx context.Context, user, statusText, statusEmoji string, statusExpiration int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserCustomStatus", ctx, user, statusText, statusEmoji, statusExpiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserCustomStatus indicates an expected call of SetUserCustomStatus.
+func (mr *MockClientMockRecorder) SetUserCustomStatus(ctx, user, statusText, statusEmoji, statusExpiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserCustomStatus", reflect.TypeOf((*MockClient)(nil).SetUserCustomStatus), ctx, user, statusText, statusEmoji, statusExpiration)
+}
+
 // UpdateUserGroup mocks base method.
 func (m *MockClient) UpdateUserGroup(ctx context.Context, userGroupID string, options ...slack.UpdateUserGroupsOption) (slack.UserGroup, error) {
 	m.ctrl.T.Helper()
@@ -209,3 +572,18 @@ func (mr *MockClientMockRecorder) UpdateUserGroupMembers(ctx, userGroup, members
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserGroupMembers", reflect.TypeOf((*MockClient)(nil).UpdateUserGroupMembers), ctx, userGroup, members)
 }
+
+// UploadFile mocks base method.
+func (m *MockClient) UploadFile(ctx context.Context, params slackExt.UploadFileParameters) (*slack.FileSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, params)
+	ret0, _ := ret[0].(*slack.FileSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockClientMockRecorder) UploadFile(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockClient)(nil).UploadFile), ctx, params)
+}
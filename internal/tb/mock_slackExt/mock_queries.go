@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	slackExt "github.com/essent/terraform-provider-slack/internal/slackExt"
 	slack "github.com/slack-go/slack"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -35,6 +36,36 @@ func (m *MockQueries) EXPECT() *MockQueriesMockRecorder {
 	return m.recorder
 }
 
+// FindConversationByName mocks base method.
+func (m *MockQueries) FindConversationByName(ctx context.Context, name string, params slack.GetConversationsParameters) (slack.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindConversationByName", ctx, name, params)
+	ret0, _ := ret[0].(slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindConversationByName indicates an expected call of FindConversationByName.
+func (mr *MockQueriesMockRecorder) FindConversationByName(ctx, name, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindConversationByName", reflect.TypeOf((*MockQueries)(nil).FindConversationByName), ctx, name, params)
+}
+
+// FindInformationBarrierByID mocks base method.
+func (m *MockQueries) FindInformationBarrierByID(ctx context.Context, barrierID string) (slackExt.InformationBarrier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInformationBarrierByID", ctx, barrierID)
+	ret0, _ := ret[0].(slackExt.InformationBarrier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInformationBarrierByID indicates an expected call of FindInformationBarrierByID.
+func (mr *MockQueriesMockRecorder) FindInformationBarrierByID(ctx, barrierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInformationBarrierByID", reflect.TypeOf((*MockQueries)(nil).FindInformationBarrierByID), ctx, barrierID)
+}
+
 // FindUserGroupByField mocks base method.
 func (m *MockQueries) FindUserGroupByField(ctx context.Context, field, value string, includeDisabled bool) (slack.UserGroup, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +80,123 @@ func (mr *MockQueriesMockRecorder) FindUserGroupByField(ctx, field, value, inclu
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserGroupByField", reflect.TypeOf((*MockQueries)(nil).FindUserGroupByField), ctx, field, value, includeDisabled)
 }
+
+// FindUsersByEmail mocks base method.
+func (m *MockQueries) FindUsersByEmail(ctx context.Context, emails []string) (map[string]slack.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsersByEmail", ctx, emails)
+	ret0, _ := ret[0].(map[string]slack.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsersByEmail indicates an expected call of FindUsersByEmail.
+func (mr *MockQueriesMockRecorder) FindUsersByEmail(ctx, emails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByEmail", reflect.TypeOf((*MockQueries)(nil).FindUsersByEmail), ctx, emails)
+}
+
+// GetAllAdminTeams mocks base method.
+func (m *MockQueries) GetAllAdminTeams(ctx context.Context) ([]slackExt.AdminTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAdminTeams", ctx)
+	ret0, _ := ret[0].([]slackExt.AdminTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAdminTeams indicates an expected call of GetAllAdminTeams.
+func (mr *MockQueriesMockRecorder) GetAllAdminTeams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAdminTeams", reflect.TypeOf((*MockQueries)(nil).GetAllAdminTeams), ctx)
+}
+
+// GetAllAuditLogs mocks base method.
+func (m *MockQueries) GetAllAuditLogs(ctx context.Context, params slackExt.AuditLogsParams, maxEntries int) ([]slackExt.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAuditLogs", ctx, params, maxEntries)
+	ret0, _ := ret[0].([]slackExt.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAuditLogs indicates an expected call of GetAllAuditLogs.
+func (mr *MockQueriesMockRecorder) GetAllAuditLogs(ctx, params, maxEntries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAuditLogs", reflect.TypeOf((*MockQueries)(nil).GetAllAuditLogs), ctx, params, maxEntries)
+}
+
+// GetAllBillableInfo mocks base method.
+func (m *MockQueries) GetAllBillableInfo(ctx context.Context, teamID string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBillableInfo", ctx, teamID)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBillableInfo indicates an expected call of GetAllBillableInfo.
+func (mr *MockQueriesMockRecorder) GetAllBillableInfo(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBillableInfo", reflect.TypeOf((*MockQueries)(nil).GetAllBillableInfo), ctx, teamID)
+}
+
+// GetAllConversations mocks base method.
+func (m *MockQueries) GetAllConversations(ctx context.Context, params slack.GetConversationsParameters) ([]slack.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllConversations", ctx, params)
+	ret0, _ := ret[0].([]slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllConversations indicates an expected call of GetAllConversations.
+func (mr *MockQueriesMockRecorder) GetAllConversations(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllConversations", reflect.TypeOf((*MockQueries)(nil).GetAllConversations), ctx, params)
+}
+
+// GetAllConversationsForUser mocks base method.
+func (m *MockQueries) GetAllConversationsForUser(ctx context.Context, params slack.GetConversationsForUserParameters) ([]slack.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllConversationsForUser", ctx, params)
+	ret0, _ := ret[0].([]slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllConversationsForUser indicates an expected call of GetAllConversationsForUser.
+func (mr *MockQueriesMockRecorder) GetAllConversationsForUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllConversationsForUser", reflect.TypeOf((*MockQueries)(nil).GetAllConversationsForUser), ctx, params)
+}
+
+// GetConversationTeams mocks base method.
+func (m *MockQueries) GetConversationTeams(ctx context.Context, channelID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationTeams", ctx, channelID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationTeams indicates an expected call of GetConversationTeams.
+func (mr *MockQueriesMockRecorder) GetConversationTeams(ctx, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationTeams", reflect.TypeOf((*MockQueries)(nil).GetConversationTeams), ctx, channelID)
+}
+
+// GetRoleAssignments mocks base method.
+func (m *MockQueries) GetRoleAssignments(ctx context.Context, roleID, entityID string) ([]slackExt.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleAssignments", ctx, roleID, entityID)
+	ret0, _ := ret[0].([]slackExt.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleAssignments indicates an expected call of GetRoleAssignments.
+func (mr *MockQueriesMockRecorder) GetRoleAssignments(ctx, roleID, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleAssignments", reflect.TypeOf((*MockQueries)(nil).GetRoleAssignments), ctx, roleID, entityID)
+}
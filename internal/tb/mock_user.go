@@ -33,6 +33,77 @@ func (b *UserBuilder) WithDeleted(deleted bool) *UserBuilder {
 	return b
 }
 
+func (b *UserBuilder) WithRealName(realName string) *UserBuilder {
+	b.result.RealName = realName
+	return b
+}
+
+func (b *UserBuilder) WithDisplayName(displayName string) *UserBuilder {
+	b.result.Profile.DisplayName = displayName
+	return b
+}
+
+func (b *UserBuilder) WithTitle(title string) *UserBuilder {
+	b.result.Profile.Title = title
+	return b
+}
+
+func (b *UserBuilder) WithPhone(phone string) *UserBuilder {
+	b.result.Profile.Phone = phone
+	return b
+}
+
+func (b *UserBuilder) WithTZ(tz string, tzOffset int) *UserBuilder {
+	b.result.TZ = tz
+	b.result.TZOffset = tzOffset
+	return b
+}
+
+func (b *UserBuilder) WithTeamID(teamID string) *UserBuilder {
+	b.result.TeamID = teamID
+	return b
+}
+
+func (b *UserBuilder) WithIsAdmin(isAdmin bool) *UserBuilder {
+	b.result.IsAdmin = isAdmin
+	return b
+}
+
+func (b *UserBuilder) WithIsOwner(isOwner bool) *UserBuilder {
+	b.result.IsOwner = isOwner
+	return b
+}
+
+func (b *UserBuilder) WithIsBot(isBot bool) *UserBuilder {
+	b.result.IsBot = isBot
+	return b
+}
+
+func (b *UserBuilder) WithBotID(botID string) *UserBuilder {
+	b.result.Profile.BotID = botID
+	return b
+}
+
+func (b *UserBuilder) WithIsRestricted(isRestricted bool) *UserBuilder {
+	b.result.IsRestricted = isRestricted
+	return b
+}
+
+func (b *UserBuilder) WithIsUltraRestricted(isUltraRestricted bool) *UserBuilder {
+	b.result.IsUltraRestricted = isUltraRestricted
+	return b
+}
+
+func (b *UserBuilder) WithIsAppUser(isAppUser bool) *UserBuilder {
+	b.result.IsAppUser = isAppUser
+	return b
+}
+
+func (b *UserBuilder) WithImage512(image512 string) *UserBuilder {
+	b.result.Profile.Image512 = image512
+	return b
+}
+
 func NewUserBuilder() *UserBuilder {
 	return &UserBuilder{
 		result: &slack.User{},
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tb
+
+import "github.com/slack-go/slack"
+
+type UserProfileBuilder struct {
+	result *slack.UserProfile
+}
+
+func (b *UserProfileBuilder) Build() *slack.UserProfile {
+	return b.result
+}
+
+func (b *UserProfileBuilder) WithStatusText(statusText string) *UserProfileBuilder {
+	b.result.StatusText = statusText
+	return b
+}
+
+func (b *UserProfileBuilder) WithStatusEmoji(statusEmoji string) *UserProfileBuilder {
+	b.result.StatusEmoji = statusEmoji
+	return b
+}
+
+func (b *UserProfileBuilder) WithStatusExpiration(statusExpiration int) *UserProfileBuilder {
+	b.result.StatusExpiration = statusExpiration
+	return b
+}
+
+func (b *UserProfileBuilder) WithCustomField(id, value string) *UserProfileBuilder {
+	fields := b.result.FieldsMap()
+	if fields == nil {
+		fields = map[string]slack.UserProfileCustomField{}
+	}
+	fields[id] = slack.UserProfileCustomField{Value: value}
+	b.result.SetFieldsMap(fields)
+	return b
+}
+
+func NewUserProfileBuilder() *UserProfileBuilder {
+	return &UserProfileBuilder{
+		result: &slack.UserProfile{},
+	}
+}
@@ -43,6 +43,21 @@ func (b *UsergroupBuilder) WithUsers(users []string) *UsergroupBuilder {
 	return b
 }
 
+func (b *UsergroupBuilder) WithTeamID(teamID string) *UsergroupBuilder {
+	b.result.TeamID = teamID
+	return b
+}
+
+func (b *UsergroupBuilder) WithUserCount(userCount int) *UsergroupBuilder {
+	b.result.UserCount = userCount
+	return b
+}
+
+func (b *UsergroupBuilder) WithDateDelete(dateDelete slack.JSONTime) *UsergroupBuilder {
+	b.result.DateDelete = dateDelete
+	return b
+}
+
 func NewUsergroupBuilder() *UsergroupBuilder {
 	return &UsergroupBuilder{
 		result: &slack.UserGroup{},
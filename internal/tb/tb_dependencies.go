@@ -15,9 +15,11 @@ type dependenciesImpl struct {
 
 	mock_slack_client  *mock_slackExt.MockClient
 	mock_slack_queries *mock_slackExt.MockQueries
+
+	mock_auth_identity slackExt.AuthIdentity
 }
 
-func (d *dependenciesImpl) CreateSlackClient(token string) slackExt.Client {
+func (d *dependenciesImpl) CreateSlackClient(token string, options ...slackExt.Option) slackExt.Client {
 	if d.mock_slack_client != nil {
 		return d.mock_slack_client
 	}
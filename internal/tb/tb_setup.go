@@ -6,6 +6,7 @@ package tb
 import (
 	"testing"
 
+	"github.com/essent/terraform-provider-slack/internal/slackExt"
 	"github.com/essent/terraform-provider-slack/internal/tb/mock_slackExt"
 	"go.uber.org/mock/gomock"
 )
@@ -16,6 +17,7 @@ func Init(t *testing.T) {
 	global.c = gomock.NewController(t)
 	global.mock_slack_client = nil
 	global.mock_slack_queries = nil
+	global.mock_auth_identity = slackExt.AuthIdentity{}
 }
 
 func Finish() {
@@ -29,6 +31,12 @@ func MockSlackClient() *mock_slackExt.MockClient {
 	return global.mock_slack_client
 }
 
+// MockAuthIdentity returns the identity the mocked client authenticates as. Tests can change it
+// before the provider is configured.
+func MockAuthIdentity() *slackExt.AuthIdentity {
+	return &global.mock_auth_identity
+}
+
 func MockSlackQueries() *mock_slackExt.MockQueries {
 	global.CreateSlackQueries(global.mock_slack_client)
 	return global.mock_slack_queries